@@ -10,14 +10,18 @@ import (
 
 	"github.com/initia-labs/OPinit/x/opchild/keeper"
 	"github.com/initia-labs/OPinit/x/opchild/types"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // BeginBlocker will persist the current header and validator set as a historical entry
 // and prune the oldest entry based on the HistoricalEntries parameter
 func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// record the block hash to build the withdrawal finalization message
+	if err := k.TrackBlockHash(ctx); err != nil {
+		return err
+	}
+
 	return k.TrackHistoricalInfo(ctx)
 }
 
@@ -25,14 +29,9 @@ func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 func EndBlocker(ctx context.Context, k *keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	height := sdkCtx.BlockHeight()
-
-	if plan, found := k.ExecutorChangePlans[uint64(height)]; found { //nolint:gosec
-		err := k.ChangeExecutor(ctx, plan)
-		if err != nil {
-			return nil, err
-		}
+	// apply the executor change plan scheduled at the current height
+	if err := k.ApplyExecutorChangePlan(ctx); err != nil {
+		return nil, err
 	}
 
 	if disabled, err := k.IsBridgeDisabled(ctx); err != nil {
@@ -44,5 +43,56 @@ func EndBlocker(ctx context.Context, k *keeper.Keeper) ([]abci.ValidatorUpdate,
 			return nil, err
 		}
 	}
+
+	// evict the pending deposits which are not applied within the timeout
+	if err := k.EvictPendingDeposits(ctx); err != nil {
+		return nil, err
+	}
+
+	// execute the forced txs submitted on L1
+	if err := k.ProcessForcedTxs(ctx); err != nil {
+		return nil, err
+	}
+
+	// migrate the OP token balances of the denoms with the auto migrate policy
+	if err := k.AutoMigrateTokens(ctx); err != nil {
+		return nil, err
+	}
+
+	// adjust the base fee by the gas used in the block
+	if err := k.UpdateBaseFee(ctx); err != nil {
+		return nil, err
+	}
+
+	// split the MEV auction proceeds between the mev rewards address and the fee collector
+	if err := k.DistributeMEVRewards(ctx); err != nil {
+		return nil, err
+	}
+
+	// distribute the collected fees by the fee splits
+	if err := k.DistributeFees(ctx); err != nil {
+		return nil, err
+	}
+
+	// prune the withdrawal records beyond the retention
+	if err := k.PruneWithdrawalRecords(ctx); err != nil {
+		return nil, err
+	}
+
+	// prune the deposit records beyond the retention
+	if err := k.PruneDepositRecords(ctx); err != nil {
+		return nil, err
+	}
+
+	// report the L2 status to L1 over the opinit channel
+	if err := k.ReportL2Status(ctx); err != nil {
+		return nil, err
+	}
+
+	// include the active sequencers in the validator set
+	if err := k.RotateSequencers(ctx); err != nil {
+		return nil, err
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -32,6 +32,15 @@ func (rbd RedundantBridgeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 					redundancies++
 				}
 				packetMsgs++
+			case *types.MsgFinalizeTokenDepositWithProof:
+				response, err := rbd.ms.FinalizeTokenDepositWithProof(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+				if response.Result == types.NOOP {
+					redundancies++
+				}
+				packetMsgs++
 			}
 		}
 
@@ -0,0 +1,38 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type BlockGasKeeper interface {
+	AddBlockGasUsed(ctx context.Context, gas uint64) error
+}
+
+// BlockGasDecorator accumulates the gas used by the delivered txs, which the fee market reads at
+// the end block to adjust the base fee. The block gas meter is not filled by the SDK, so the gas is
+// counted by this post handler. It must be the last post decorator, so the gas it counts includes
+// the other post decorators.
+type BlockGasDecorator struct {
+	keeper BlockGasKeeper
+}
+
+// NewBlockGasDecorator create BlockGasDecorator instance
+func NewBlockGasDecorator(keeper BlockGasKeeper) BlockGasDecorator {
+	return BlockGasDecorator{
+		keeper,
+	}
+}
+
+func (bgd BlockGasDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if simulate || ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if err := bgd.keeper.AddBlockGasUsed(ctx, ctx.GasMeter().GasConsumed()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
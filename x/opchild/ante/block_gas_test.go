@@ -0,0 +1,59 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/ante"
+)
+
+func TestBlockGasDecorator(t *testing.T) {
+	ctx, input := createTestInput(t, false)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.TargetBlockGas = 1_000_000
+	params.BaseFeeChangeDenominator = 8
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	postHandler := sdk.ChainPostDecorators(ante.NewBlockGasDecorator(&input.OPChildKeeper))
+	deliverTx := func(ctx sdk.Context, gasUsed uint64, simulate bool) {
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+		ctx.GasMeter().ConsumeGas(gasUsed, "test")
+
+		_, err := postHandler(ctx, testTx{}, simulate, true)
+		require.NoError(t, err)
+	}
+
+	// the gas used by the simulated and check txs is not counted
+	deliverTx(sdkCtx, 5_000_000, true)
+	deliverTx(sdkCtx.WithIsCheckTx(true), 5_000_000, false)
+
+	deliverTx(sdkCtx, 1_000_000, false)
+	deliverTx(sdkCtx, 1_000_000, false)
+
+	gasUsed, err := input.OPChildKeeper.BlockGasUsed.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2_000_000), gasUsed)
+
+	// the end block raises the base fee by the accumulated gas
+	require.NoError(t, input.OPChildKeeper.UpdateBaseFee(ctx))
+
+	multiplier, err := input.OPChildKeeper.GetBaseFeeMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1125, 3), multiplier)
+
+	// the next block starts from zero gas, which lowers the base fee
+	require.NoError(t, input.OPChildKeeper.UpdateBaseFee(ctx))
+
+	multiplier, err = input.OPChildKeeper.GetBaseFeeMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1125, 3).Mul(math.LegacyNewDecWithPrec(875, 3)), multiplier)
+}
@@ -3,4 +3,5 @@ package ante
 const (
 	SimulationFlagContextKey = iota
 	GasPricesContextKey
+	SponsoredTxContextKey
 )
@@ -13,6 +13,8 @@ import (
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
 // If fee is high enough or not CheckTx, then call next AnteHandler
+// The priority of the tx is the tip over the base fee of the fee market.
+// The txs sponsored by SponsorshipDecorator skip the minimum fee check.
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeChecker
 type MempoolFeeChecker struct {
 	keeper rolluptypes.AnteKeeper
@@ -36,16 +38,22 @@ func (mfd MempoolFeeChecker) CheckTxFeeWithMinGasPrices(ctx sdk.Context, tx sdk.
 	feeCoins := feeTx.GetFee()
 	gas := feeTx.GetGas()
 
-	if ctx.IsCheckTx() {
+	// the priority is only used by the mempool
+	priority := int64(1) // FIFO
+	if sponsored, _ := ctx.Value(SponsoredTxContextKey).(bool); sponsored {
+		return feeCoins, priority, nil
+	}
 
+	if ctx.IsCheckTx() {
 		minGasPrices := ctx.MinGasPrices()
 		if mfd.keeper != nil {
-			paramsMinGasPrices, err := mfd.keeper.MinGasPrices(ctx)
+			baseGasPrices, err := mfd.keeper.MinGasPrices(ctx)
 			if err != nil {
 				return nil, 0, err
 			}
 
-			minGasPrices = CombinedMinGasPrices(minGasPrices, paramsMinGasPrices)
+			minGasPrices = CombinedMinGasPrices(minGasPrices, baseGasPrices)
+			priority = computePriority(feeCoins, gas, baseGasPrices)
 		}
 
 		if !minGasPrices.IsZero() {
@@ -57,7 +65,5 @@ func (mfd MempoolFeeChecker) CheckTxFeeWithMinGasPrices(ctx sdk.Context, tx sdk.
 		}
 	}
 
-	// TODO - if we want to use ethereum like priority system,
-	// then we need to compute all dex prices of all fee coins
-	return feeCoins, 1 /* FIFO */, nil
+	return feeCoins, priority, nil
 }
@@ -87,6 +87,48 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	suite.Require().NotNil(err, "Decorator should have errored on too low fee for local gasPrice")
 }
 
+func (suite *AnteTestSuite) TestMempoolFeePriority() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// base fee = 0.001 * 200_000 = 200
+	fc := ante.NewMempoolFeeChecker(TestAnteKeeper{
+		minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(1, 3))),
+	})
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+	gasLimit := uint64(200_000)
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetGasLimit(gasLimit)
+	suite.ctx = suite.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	checkPriority := func(feeAmount int64) int64 {
+		suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(feeAmount))))
+		tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		suite.Require().NoError(err)
+
+		_, priority, err := fc.CheckTxFeeWithMinGasPrices(suite.ctx, tx)
+		suite.Require().NoError(err)
+		return priority
+	}
+
+	// no tip
+	suite.Require().Equal(int64(1), checkPriority(200))
+
+	// the tip is the ratio over the base fee
+	suite.Require().Equal(int64(1+ante.PriorityPrecision/2), checkPriority(300))
+	suite.Require().Equal(int64(1+ante.PriorityPrecision), checkPriority(400))
+
+	// the fee denom without a base fee has no tip
+	fc = ante.NewMempoolFeeChecker(TestAnteKeeper{
+		minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("test", math.LegacyZeroDec())),
+	})
+	suite.Require().Equal(int64(1), checkPriority(400))
+}
+
 func (suite *AnteTestSuite) TestCombinedMinGasPrices() {
 	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(100)))
 	configMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(100)))
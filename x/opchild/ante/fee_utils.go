@@ -1,10 +1,15 @@
 package ante
 
 import (
+	gomath "math"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// PriorityPrecision is the precision of the tip ratio in the tx priority.
+const PriorityPrecision = 1_000_000
+
 // CombinedMinGasPrices will combine the on-chain fee and min_gas_prices.
 func CombinedMinGasPrices(minGasPrices sdk.DecCoins, configMinGasPrices sdk.DecCoins) sdk.DecCoins {
 	for _, cmgp := range configMinGasPrices {
@@ -34,3 +39,32 @@ func computeRequiredFees(gas uint64, minGasPrices sdk.DecCoins) sdk.Coins {
 
 	return requiredFees.Sort()
 }
+
+// computePriority returns the tx priority by the tip over the base fee. The tip is the ratio of the
+// fee exceeding the base fee, so it is comparable across the fee denoms, and the highest tip of the
+// fee denoms is used. The priority is 1 + tip * PriorityPrecision, and 1 (FIFO) without a tip.
+func computePriority(feeCoins sdk.Coins, gas uint64, baseGasPrices sdk.DecCoins) int64 {
+	maxPriority := math.OneInt()
+	for _, baseFee := range computeRequiredFees(gas, baseGasPrices) {
+		if !baseFee.IsPositive() {
+			continue
+		}
+
+		fee := feeCoins.AmountOf(baseFee.Denom)
+		if fee.LTE(baseFee.Amount) {
+			continue
+		}
+
+		// priority = 1 + (fee - base_fee) * precision / base_fee
+		priority := fee.Sub(baseFee.Amount).MulRaw(PriorityPrecision).Quo(baseFee.Amount).AddRaw(1)
+		if priority.GT(maxPriority) {
+			maxPriority = priority
+		}
+	}
+
+	if !maxPriority.IsInt64() {
+		return gomath.MaxInt64
+	}
+
+	return maxPriority.Int64()
+}
@@ -0,0 +1,49 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type SponsorshipKeeper interface {
+	ConsumeSponsorship(ctx context.Context, feePayer []byte, msgs []sdk.Msg, gas uint64) (bool, error)
+}
+
+// SponsorshipDecorator charges the gas limit of the zero fee txs to the sponsorship of the fee
+// payer or the message type. The sponsored txs are marked in the context, so MempoolFeeChecker
+// accepts them without fees. It must be placed before the fee deduction decorator.
+// CONTRACT: Tx must implement FeeTx to use SponsorshipDecorator
+type SponsorshipDecorator struct {
+	keeper SponsorshipKeeper
+}
+
+// NewSponsorshipDecorator create SponsorshipDecorator instance
+func NewSponsorshipDecorator(keeper SponsorshipKeeper) SponsorshipDecorator {
+	return SponsorshipDecorator{
+		keeper,
+	}
+}
+
+func (sd SponsorshipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the txs paying fees or using fee grants are not sponsored
+	if !feeTx.GetFee().IsZero() || feeTx.FeeGranter() != nil {
+		return next(ctx, tx, simulate)
+	}
+
+	sponsored, err := sd.keeper.ConsumeSponsorship(ctx, feeTx.FeePayer(), tx.GetMsgs(), feeTx.GetGas())
+	if err != nil {
+		return ctx, err
+	} else if sponsored {
+		ctx = ctx.WithValue(SponsoredTxContextKey, true)
+	}
+
+	return next(ctx, tx, simulate)
+}
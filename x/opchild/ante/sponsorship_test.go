@@ -0,0 +1,75 @@
+package ante_test
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/initia-labs/OPinit/x/opchild/ante"
+)
+
+type TestSponsorshipKeeper struct {
+	budget *uint64
+}
+
+func (k TestSponsorshipKeeper) ConsumeSponsorship(ctx context.Context, feePayer []byte, msgs []sdk.Msg, gas uint64) (bool, error) {
+	if *k.budget < gas {
+		return false, nil
+	}
+
+	*k.budget -= gas
+	return true, nil
+}
+
+func (suite *AnteTestSuite) TestSponsorshipDecorator() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	budget := uint64(300_000)
+	sd := ante.NewSponsorshipDecorator(TestSponsorshipKeeper{budget: &budget})
+	fc := ante.NewMempoolFeeChecker(TestAnteKeeper{
+		minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(1, 3))),
+	})
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+	gasLimit := uint64(200_000)
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetGasLimit(gasLimit)
+	suite.ctx = suite.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	suite.Require().NoError(err)
+
+	checkFee := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		_, _, err := fc.CheckTxFeeWithMinGasPrices(ctx, tx)
+		return ctx, err
+	}
+
+	// the zero fee tx is sponsored
+	_, err = sd.AnteHandle(suite.ctx, tx, false, checkFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(100_000), budget)
+
+	// the budget is not enough
+	_, err = sd.AnteHandle(suite.ctx, tx, false, checkFee)
+	suite.Require().Error(err)
+	suite.Require().Equal(uint64(100_000), budget)
+
+	// the tx paying fees is not sponsored
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(200))))
+	tx, err = suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	suite.Require().NoError(err)
+
+	budget = 300_000
+	_, err = sd.AnteHandle(suite.ctx, tx, false, checkFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(300_000), budget)
+}
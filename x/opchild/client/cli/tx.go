@@ -43,6 +43,7 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewSetBridgeInfoCmd(ac),
 		NewUpdateOracleCmd(ac),
 		NewMigrateTokenCmd(ac),
+		NewUnmigrateTokenCmd(ac),
 		NewRelayOracleDataCmd(ac),
 	)
 
@@ -246,6 +247,42 @@ func NewMigrateTokenCmd(ac address.Codec) *cobra.Command {
 	return cmd
 }
 
+// NewUnmigrateTokenCmd returns a CLI command handler for the transaction converting a migrated IBC token back to L2 token.
+func NewUnmigrateTokenCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unmigrate-token [amount]",
+		Short: "convert a migrated IBC token back to L2 token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnmigrateToken(fromAddr, amount)
+			if err := msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewExecuteMessagesCmd returns a CLI command handler for transaction to administrating the system.
 func NewExecuteMessagesCmd(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -13,6 +13,7 @@ import (
 
 	"github.com/initia-labs/OPinit/x/opchild/keeper"
 	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 var (
@@ -146,7 +147,32 @@ func (im IBCModule) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	ackBytes, err := im.keeper.OnRecvAttestorSetUpdatePacket(ctx, packet.GetData())
+	// remember the L2 side of the opinit channel to send packets to L1
+	if err := im.keeper.RecordOPinitChannel(ctx, packet.GetDestPort(), packet.GetSourceChannel(), packet.GetDestChannel()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	var ackBytes []byte
+	var err error
+
+	// attestor set update packets are sent without the envelope
+	if data, decodeErr := ophosttypes.DecodeOPinitPacketData(packet.GetData()); decodeErr == nil {
+		// oracle prices and forced txs are only trusted from the opinit channel connected to L1
+		if err := im.keeper.ValidateOPinitChannel(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
+		switch {
+		case data.OraclePriceUpdate != nil:
+			ackBytes, err = im.keeper.OnRecvOraclePriceUpdatePacket(ctx, *data.OraclePriceUpdate)
+		case data.ForcedTx != nil:
+			ackBytes, err = im.keeper.OnRecvForcedTxPacket(ctx, *data.ForcedTx)
+		default:
+			return channeltypes.NewErrorAcknowledgement(fmt.Errorf("opchild module on L2 does not receive l2 status reports"))
+		}
+	} else {
+		ackBytes, err = im.keeper.OnRecvAttestorSetUpdatePacket(ctx, packet.GetData())
+	}
 	if err == nil {
 		return channeltypes.NewResultAcknowledgement(ackBytes)
 	}
@@ -162,7 +188,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	// currently L2 opchild module doesn't send packets on this port, only receives
+	// L2 only sends l2 status reports, which are informational; just emit the acknowledgement
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypePacket,
@@ -181,7 +207,6 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	// currently opchild L2 doesn't send packets on this port, only receives
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
@@ -189,5 +214,10 @@ func (im IBCModule) OnTimeoutPacket(
 		),
 	)
 
+	// report the l2 status again at the next block
+	if data, err := ophosttypes.DecodeOPinitPacketData(packet.GetData()); err == nil && data.L2StatusReport != nil {
+		return im.keeper.OnTimeoutL2StatusReportPacket(ctx)
+	}
+
 	return nil
 }
@@ -1,12 +1,16 @@
 package opchild_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 
 	"github.com/initia-labs/OPinit/x/opchild"
 	"github.com/initia-labs/OPinit/x/opchild/testutil"
@@ -235,6 +239,85 @@ func Test_IBCModule_OnRecvPacket_AttestorSetUpdate(t *testing.T) {
 	require.Len(t, vals, 2)
 }
 
+func Test_IBCModule_OnRecvPacket_OraclePriceUpdate(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	input.OracleKeeper.InitGenesis(sdkCtx, oracletypes.GenesisState{
+		CurrencyPairGenesis: make([]oracletypes.CurrencyPairGenesis, 0),
+	})
+
+	cp, err := connecttypes.CurrencyPairFromString("ETH/USD")
+	require.NoError(t, err)
+	err = input.OracleKeeper.CreateCurrencyPair(sdkCtx, cp)
+	require.NoError(t, err)
+
+	bridgeInfo := opchildtypes.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: testutil.AddrsStr[0],
+		L1ChainId:  "test-chain-1",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			ChannelId:     "channel-0",
+			OracleEnabled: true,
+		},
+	}
+	err = input.OPChildKeeper.BridgeInfo.Set(ctx, bridgeInfo)
+	require.NoError(t, err)
+
+	input.IBCKeeper.ConnectionKeeper.SetConnection(sdkCtx, "connection-0", connectiontypes.ConnectionEnd{
+		State:    connectiontypes.OPEN,
+		ClientId: "test-client-id",
+	})
+	input.IBCKeeper.ChannelKeeper.SetChannel(sdkCtx, opchildtypes.PortID, "channel-1", channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		Ordering:       channeltypes.UNORDERED,
+		Counterparty:   channeltypes.NewCounterparty(opchildtypes.PortID, "channel-0"),
+		ConnectionHops: []string{"connection-0"},
+	})
+
+	packetData := ophosttypes.NewOraclePriceUpdatePacket(ophosttypes.OraclePriceUpdatePacketData{
+		BridgeId: 1,
+		Prices: []ophosttypes.OraclePrice{
+			{CurrencyPairId: 0, CurrencyPair: "ETH/USD", Price: "300000", Timestamp: 1000000000},
+		},
+		L1BlockHeight: 100,
+		L1BlockTime:   1000000000,
+	})
+
+	packet := channeltypes.Packet{
+		SourcePort:         opchildtypes.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    opchildtypes.PortID,
+		DestinationChannel: "channel-1",
+		Data:               packetData.GetBytes(),
+		Sequence:           1,
+	}
+
+	ibcModule := opchild.NewIBCModule(input.OPChildKeeper)
+
+	// packet from a channel other than the opinit channel
+	spoofed := packet
+	spoofed.DestinationChannel = "channel-2"
+	ack := ibcModule.OnRecvPacket(sdkCtx, opchildtypes.Version, spoofed, nil)
+	require.False(t, ack.Success())
+
+	_, err = input.OracleKeeper.GetPriceForCurrencyPair(sdkCtx, cp)
+	require.Error(t, err)
+
+	ack = ibcModule.OnRecvPacket(sdkCtx, opchildtypes.Version, packet, nil)
+	require.True(t, ack.Success())
+
+	var packetAck ophosttypes.OraclePriceUpdatePacketAck
+	err = json.Unmarshal(ack.(channeltypes.Acknowledgement).GetResult(), &packetAck)
+	require.NoError(t, err)
+	require.True(t, packetAck.Success, packetAck.Error)
+
+	price, err := input.OracleKeeper.GetPriceForCurrencyPair(sdkCtx, cp)
+	require.NoError(t, err)
+	require.Equal(t, "300000", price.Price.String())
+}
+
 func Test_IBCModule_OnRecvPacket_InvalidData(t *testing.T) {
 	ctx, input := testutil.CreateTestInput(t, false)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -100,7 +100,18 @@ func (k Keeper) updateAttestorsFromPacket(ctx context.Context, attestorSet []oph
 
 	existingAttestors := make(map[string]types.Validator)
 	for _, validator := range validators {
-		if validator.ConsPower == types.AttestorConsPower {
+		if validator.ConsPower != types.AttestorConsPower {
+			continue
+		}
+
+		// a sequencer can have the same power as the attestors
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.OperatorAddress)
+		if err != nil {
+			return err
+		}
+		if isSequencer, err := k.Sequencers.Has(ctx, valAddr); err != nil {
+			return err
+		} else if !isSequencer {
 			existingAttestors[validator.OperatorAddress] = validator
 		}
 	}
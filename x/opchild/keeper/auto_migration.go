@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// MaxAutoMigrateAccounts is the maximum number of the accounts processed by the auto migration
+// in a block, over all denoms.
+const MaxAutoMigrateAccounts = 100
+
+// GetAutoMigration returns the progress of the auto migration of the OP token.
+func (k Keeper) GetAutoMigration(ctx context.Context, denom string) (types.AutoMigration, error) {
+	autoMigration, err := k.AutoMigrations.Get(ctx, denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.AutoMigration{Denom: denom}, nil
+	}
+
+	return autoMigration, err
+}
+
+// AutoMigrateTokens migrates the OP token balances of the accounts to the IBC token for the
+// denoms with the auto migrate policy. The accounts are walked from the last processed address,
+// and at most MaxAutoMigrateAccounts accounts are processed in a block.
+func (k Keeper) AutoMigrateTokens(ctx context.Context) error {
+	// the shutdown process withdraws the balances instead
+	if disabled, err := k.IsBridgeDisabled(ctx); err != nil {
+		return err
+	} else if disabled {
+		return nil
+	}
+
+	var migrationInfos []types.MigrationInfo
+	if err := k.IterateMigrationInfos(ctx, func(_ string, migrationInfo types.MigrationInfo) (stop bool, err error) {
+		if migrationInfo.AutoMigrate && !migrationInfo.MigrationPaused {
+			migrationInfos = append(migrationInfos, migrationInfo)
+		}
+
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	limit := MaxAutoMigrateAccounts
+	for _, migrationInfo := range migrationInfos {
+		if limit == 0 {
+			break
+		}
+
+		processed, err := k.autoMigrateToken(ctx, migrationInfo, limit)
+		if err != nil {
+			return err
+		}
+
+		limit -= processed
+	}
+
+	return nil
+}
+
+// autoMigrateToken migrates the OP token balances of at most limit accounts, and returns the
+// number of the processed accounts.
+func (k Keeper) autoMigrateToken(ctx context.Context, migrationInfo types.MigrationInfo, limit int) (int, error) {
+	autoMigration, err := k.GetAutoMigration(ctx, migrationInfo.Denom)
+	if err != nil {
+		return 0, err
+	} else if autoMigration.Done {
+		return 0, nil
+	}
+
+	// get the auth keeper
+	authKeeper, ok := k.authKeeper.(*authkeeper.AccountKeeper)
+	if !ok {
+		return 0, errors.New("unexpected auth keeper type")
+	}
+
+	// iterate the accounts from the last processed address
+	iter, err := authKeeper.Accounts.Iterate(ctx, new(collections.Range[sdk.AccAddress]).StartExclusive(autoMigration.LastAddr))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	processed, migrated := 0, uint64(0)
+	for ; iter.Valid() && processed < limit; iter.Next() {
+		addr, err := iter.Key()
+		if err != nil {
+			return 0, err
+		}
+		acc, err := iter.Value()
+		if err != nil {
+			return 0, err
+		}
+
+		processed++
+		autoMigration.LastAddr = addr
+
+		if _, ok := acc.(sdk.ModuleAccountI); ok {
+			continue
+		}
+
+		// only migrate the spendable amount, so the vesting schedules are kept
+		spendable := k.bankKeeper.SpendableCoin(ctx, addr, migrationInfo.Denom)
+		if !spendable.IsPositive() {
+			continue
+		}
+
+		// use cache context to skip the account on failure, instead of halting the chain
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if _, err := k.MigrateToken(cacheCtx, migrationInfo, addr, spendable); err != nil {
+			k.Logger(ctx).Error("failed to auto migrate token",
+				"denom", migrationInfo.Denom,
+				"address", addr.String(),
+				"error", err.Error(),
+			)
+			continue
+		}
+		writeCache()
+
+		migrated++
+	}
+
+	autoMigration.NumAccounts += migrated
+	autoMigration.Done = !iter.Valid()
+	if err := k.AutoMigrations.Set(ctx, migrationInfo.Denom, autoMigration); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAutoMigrateToken,
+		sdk.NewAttribute(types.AttributeKeyDenom, migrationInfo.Denom),
+		sdk.NewAttribute(types.AttributeKeyNumAccounts, strconv.FormatUint(migrated, 10)),
+		sdk.NewAttribute(types.AttributeKeyDone, strconv.FormatBool(autoMigration.Done)),
+	))
+
+	return processed, nil
+}
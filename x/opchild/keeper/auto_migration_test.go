@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+func Test_AutoMigrateTokens(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	// Set up denom pair first (L1 token)
+	err := input.OPChildKeeper.DenomPairs.Set(ctx, "test1", "test1")
+	require.NoError(t, err)
+
+	authority := authtypes.NewModuleAddress(types.ModuleName).String()
+	migrationInfo := types.MigrationInfo{
+		Denom:        "test1",
+		IbcChannelId: "channel-0",
+		IbcPortId:    "transfer",
+	}
+	_, err = ms.RegisterMigrationInfo(ctx, types.NewMsgRegisterMigrationInfo(authority, migrationInfo))
+	require.NoError(t, err)
+
+	numAccounts := keeper.MaxAutoMigrateAccounts + 10
+	accounts := make([]sdk.AccAddress, 0, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		accounts = append(accounts, input.Faucet.NewFundedAccount(ctx, sdk.NewCoin("test1", math.NewInt(10))))
+	}
+
+	// auto migration is disabled by default
+	require.NoError(t, input.OPChildKeeper.AutoMigrateTokens(ctx))
+	_, err = input.OPChildKeeper.AutoMigrations.Get(ctx, "test1")
+	require.Error(t, err)
+
+	_, err = ms.UpdateMigrationToggles(ctx, types.NewMsgUpdateMigrationToggles(authority, "test1", false, false, true))
+	require.NoError(t, err)
+
+	// the accounts are processed in a bounded number per block
+	require.NoError(t, input.OPChildKeeper.AutoMigrateTokens(ctx))
+	autoMigration, err := input.OPChildKeeper.GetAutoMigration(ctx, "test1")
+	require.NoError(t, err)
+	require.False(t, autoMigration.Done)
+	require.NotEmpty(t, autoMigration.LastAddr)
+	require.LessOrEqual(t, autoMigration.NumAccounts, uint64(keeper.MaxAutoMigrateAccounts))
+
+	for i := 0; i < 10 && !autoMigration.Done; i++ {
+		require.NoError(t, input.OPChildKeeper.AutoMigrateTokens(ctx))
+		autoMigration, err = input.OPChildKeeper.GetAutoMigration(ctx, "test1")
+		require.NoError(t, err)
+	}
+	require.True(t, autoMigration.Done)
+	require.GreaterOrEqual(t, autoMigration.NumAccounts, uint64(numAccounts))
+
+	// every balance is migrated to the IBC token
+	ibcDenom := transfertypes.GetTransferCoin(migrationInfo.IbcPortId, migrationInfo.IbcChannelId, "test1", math.ZeroInt()).Denom
+	for _, account := range accounts {
+		require.True(t, input.BankKeeper.GetBalance(ctx, account, "test1").IsZero())
+		require.Equal(t, math.NewInt(10), input.BankKeeper.GetBalance(ctx, account, ibcDenom).Amount)
+	}
+
+	migrationStatus, err := input.OPChildKeeper.GetMigrationStatus(ctx, "test1")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(int64(numAccounts)*10), migrationStatus.Migrated.Amount)
+
+	// the finished auto migration does not walk the accounts again
+	account := input.Faucet.NewFundedAccount(ctx, sdk.NewCoin("test1", math.NewInt(10)))
+	require.NoError(t, input.OPChildKeeper.AutoMigrateTokens(ctx))
+	require.Equal(t, math.NewInt(10), input.BankKeeper.GetBalance(ctx, account, "test1").Amount)
+
+	// enabling the auto migration again restarts it from the first account
+	_, err = ms.UpdateMigrationToggles(ctx, types.NewMsgUpdateMigrationToggles(authority, "test1", false, false, false))
+	require.NoError(t, err)
+	_, err = ms.UpdateMigrationToggles(ctx, types.NewMsgUpdateMigrationToggles(authority, "test1", false, false, true))
+	require.NoError(t, err)
+
+	autoMigration, err = input.OPChildKeeper.GetAutoMigration(ctx, "test1")
+	require.NoError(t, err)
+	require.Equal(t, types.AutoMigration{Denom: "test1"}, autoMigration)
+
+	for i := 0; i < 10 && !autoMigration.Done; i++ {
+		require.NoError(t, input.OPChildKeeper.AutoMigrateTokens(ctx))
+		autoMigration, err = input.OPChildKeeper.GetAutoMigration(ctx, "test1")
+		require.NoError(t, err)
+	}
+	require.True(t, input.BankKeeper.GetBalance(ctx, account, "test1").IsZero())
+}
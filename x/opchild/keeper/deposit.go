@@ -4,13 +4,37 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
-func (k Keeper) handleBridgeHook(ctx sdk.Context, data []byte, hookMaxGas uint64) (success bool, reason string) {
+// handleBridgeHook executes the hook data of the deposit. The data is either a signed tx or
+// an unsigned route memo, which forwards the deposited tokens from the recipient.
+func (k Keeper) handleBridgeHook(ctx sdk.Context, from, to string, amount sdk.Coin, data []byte, hookMaxGas uint64) (success bool, reason string) {
+	return k.runWithHookGas(ctx, hookMaxGas, "bridge hook", func(ctx sdk.Context) (bool, string) {
+		// the route memo is executed without signature verification
+		if memo, ok := ophosttypes.ParseDepositRouteMemo(data); ok {
+			if err := k.handleDepositRoute(ctx, *memo.Route, from, to, amount); err != nil {
+				return false, fmt.Sprintf("Failed to handle deposit route: %s", err)
+			}
+
+			return true, ""
+		}
+
+		return k.executeSignedTx(ctx, data)
+	})
+}
+
+// runWithHookGas runs the function with a gas meter limited by the hook max gas, and recovers
+// from the panic of the function. The gas used by the function is charged to the origin gas meter.
+func (k Keeper) runWithHookGas(ctx sdk.Context, hookMaxGas uint64, descriptor string, fn func(ctx sdk.Context) (bool, string)) (success bool, reason string) {
 	if hookMaxGas == 0 {
 		return false, "hook max gas is zero"
 	}
@@ -18,57 +42,128 @@ func (k Keeper) handleBridgeHook(ctx sdk.Context, data []byte, hookMaxGas uint64
 	originGasMeter := ctx.GasMeter()
 	gasForHook := min(originGasMeter.GasRemaining(), hookMaxGas)
 
+	// use new gas meter with the hook max gas limit
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasForHook))
+
 	defer func() {
 		if r := recover(); r != nil {
+			success = false
 			reason = fmt.Sprintf("panic: %v", r)
 		}
 
-		const maxReasonLength = 128
-		if len(reason) > maxReasonLength {
-			reason = reason[:maxReasonLength] + "..."
-		}
-
-		originGasMeter.ConsumeGas(ctx.GasMeter().GasConsumedToLimit(), "bridge hook")
+		originGasMeter.ConsumeGas(ctx.GasMeter().GasConsumedToLimit(), descriptor)
 	}()
 
-	// use new gas meter with the hook max gas limit
-	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasForHook))
+	return fn(ctx)
+}
 
-	tx, err := k.txDecoder(data)
+// executeSignedTx decodes the signed tx, runs the decorators to verify the signatures, and
+// executes the messages of the tx. The message state changes are discarded on failure.
+func (k Keeper) executeSignedTx(ctx sdk.Context, txBytes []byte) (bool, string) {
+	tx, err := k.txDecoder(txBytes)
 	if err != nil {
-		reason = fmt.Sprintf("Failed to decode tx: %s", err)
-		return
+		return false, fmt.Sprintf("Failed to decode tx: %s", err)
 	}
 
 	ctx, err = k.decorators(ctx, tx, false)
 	if err != nil {
-		reason = fmt.Sprintf("Failed to run AnteHandler: %s", err)
-		return
+		return false, fmt.Sprintf("Failed to run AnteHandler: %s", err)
 	}
 
 	// use cache context from here to avoid resetting sequencer number on failure
 	cacheCtx, commit := ctx.CacheContext()
-	for _, msg := range tx.GetMsgs() {
+	if err := k.executeHookMsgs(cacheCtx, tx.GetMsgs()); err != nil {
+		return false, err.Error()
+	}
+
+	commit()
+	return true, ""
+}
+
+// handleDepositRoute forwards the deposited tokens by the route. All state changes are
+// discarded on failure.
+func (k Keeper) handleDepositRoute(ctx sdk.Context, route ophosttypes.DepositRoute, from, to string, amount sdk.Coin) error {
+	if err := route.Validate(); err != nil {
+		return err
+	}
+
+	var msgs []sdk.Msg
+	switch {
+	case route.IBCTransfer != nil:
+		transfer := route.IBCTransfer
+		timeoutTimestamp := transfer.TimeoutTimestamp
+		if timeoutTimestamp == 0 {
+			timeoutTimestamp = uint64(ctx.BlockTime().Add(ophosttypes.DefaultPacketTimeoutTimestamp).UnixNano()) //nolint:gosec
+		}
+
+		// forward the deposited tokens from the recipient
+		msgs = append(msgs, transfertypes.NewMsgTransfer(
+			transfer.SourcePort,
+			transfer.SourceChannel,
+			amount,
+			to,
+			transfer.Receiver,
+			ophosttypes.DefaultTransferPacketTimeoutHeight,
+			timeoutTimestamp,
+			transfer.Memo,
+		))
+	case route.ContractCall != nil:
+		// the messages can only be signed by the intermediate sender of the depositor,
+		// so the depositor cannot act on behalf of the other accounts.
+		sender := ophosttypes.DepositRouteIntermediateSender(from)
+		for _, msgBz := range route.ContractCall.Msgs {
+			var msg sdk.Msg
+			if err := k.cdc.UnmarshalInterfaceJSON(msgBz, &msg); err != nil {
+				return err
+			}
+
+			signers, _, err := k.cdc.GetMsgV1Signers(msg)
+			if err != nil {
+				return err
+			}
+
+			for _, signer := range signers {
+				if !sender.Equals(sdk.AccAddress(signer)) {
+					return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message must be signed by the intermediate sender %s", sender)
+				}
+			}
+
+			msgs = append(msgs, msg)
+		}
+
+		// create the intermediate sender account if it does not exist
+		if !k.authKeeper.HasAccount(ctx, sender) {
+			k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, sender))
+		}
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.executeHookMsgs(cacheCtx, msgs); err != nil {
+		return err
+	}
+
+	commit()
+	return nil
+}
+
+// executeHookMsgs executes the messages of the bridge hook and emits the events.
+func (k Keeper) executeHookMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
 		handler := k.msgRouter.Handler(msg)
 		if handler == nil {
-			reason = fmt.Sprintf("Unrecognized Msg type: %s", sdk.MsgTypeURL(msg))
-			return
+			return fmt.Errorf("unrecognized Msg type: %s", sdk.MsgTypeURL(msg))
 		}
 
-		res, err := handler(cacheCtx, msg)
+		res, err := handler(ctx, msg)
 		if err != nil {
-			reason = fmt.Sprintf("Failed to execute Msg: %s", err)
-			return
+			return fmt.Errorf("failed to execute Msg: %w", err)
 		}
 
 		// emit events
-		cacheCtx.EventManager().EmitEvents(res.GetEvents())
+		ctx.EventManager().EmitEvents(res.GetEvents())
 	}
 
-	commit()
-	success = true
-
-	return
+	return nil
 }
 
 // safeDepositToken mint and send coins to the recipient. Rollback all state changes
@@ -89,11 +184,6 @@ func (ms MsgServer) safeDepositToken(ctx context.Context, toAddr sdk.AccAddress,
 		if r := recover(); r != nil {
 			reason = fmt.Sprintf("panic: %v", r)
 		}
-
-		const maxReasonLength = 128
-		if len(reason) > maxReasonLength {
-			reason = reason[:maxReasonLength] + "..."
-		}
 	}()
 
 	// use cache context to avoid relaying failure
@@ -118,3 +208,20 @@ func (ms MsgServer) safeDepositToken(ctx context.Context, toAddr sdk.AccAddress,
 
 	return
 }
+
+const (
+	// maxEventReasonLength is the max length of the failure reason emitted in the event.
+	maxEventReasonLength = 128
+	// maxRecordReasonLength is the max length of the failure reason stored in the deposit record.
+	maxRecordReasonLength = 1024
+)
+
+// truncateReason truncates the failure reason to the max length, so an arbitrary long error
+// of the deposit or the hook does not bloat the events and the state.
+func truncateReason(reason string, maxLength int) string {
+	if len(reason) > maxLength {
+		return reason[:maxLength] + "..."
+	}
+
+	return reason
+}
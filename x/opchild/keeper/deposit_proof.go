@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// verifyDepositProof verifies the deposit commitment stored in the ophost state with the
+// L1 light client, so the deposit can be finalized without the bridge executor.
+func (k Keeper) verifyDepositProof(ctx context.Context, req *types.MsgFinalizeTokenDepositWithProof) error {
+	if err := k.ensureIBCKeepersSet(); err != nil {
+		return err
+	}
+
+	bridgeInfo, err := k.BridgeInfo.Get(ctx)
+	if err != nil {
+		return types.ErrBridgeInfoNotExists
+	}
+
+	if bridgeInfo.L1ClientId == "" {
+		return types.ErrInvalidBridgeInfo.Wrap("l1 client id is not set")
+	}
+
+	// the l2 denom is not committed on L1, so it should be derived from the base denom
+	if l2Denom := ophosttypes.L2Denom(bridgeInfo.BridgeId, req.BaseDenom); req.Amount.Denom != l2Denom {
+		return types.ErrInvalidDepositProof.Wrapf("expected denom %s, got %s", l2Denom, req.Amount.Denom)
+	}
+
+	commitment := ophosttypes.GenerateDepositCommitment(
+		bridgeInfo.BridgeId,
+		req.Sequence,
+		req.Height,
+		req.From,
+		req.To,
+		req.BaseDenom,
+		req.Amount.Amount,
+		req.Data,
+	)
+
+	// convert ProofOps to ICS-23 MerkleProof format
+	merkleProof, err := convertProofOpsToMerkleProof(req.Proof)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to convert proof to merkle proof")
+	}
+	merkleProofBz, err := k.cdc.Marshal(&merkleProof)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal merkle proof")
+	}
+
+	merklePath := commitmenttypesv2.NewMerklePath(
+		[]byte(ophosttypes.StoreKey),
+		ophosttypes.GetDepositCommitmentKey(bridgeInfo.BridgeId, req.Sequence),
+	)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, found := k.ibcClientKeeper.GetClientState(sdkCtx, bridgeInfo.L1ClientId); !found {
+		return types.ErrInvalidDepositProof.Wrap("L1 IBC client state not found")
+	}
+
+	if err := k.ibcClientKeeper.VerifyMembership(
+		sdkCtx,
+		bridgeInfo.L1ClientId,
+		req.ProofHeight,
+		0,
+		0,
+		merkleProofBz,
+		merklePath,
+		commitment[:],
+	); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidDepositProof, err.Error())
+	}
+
+	return nil
+}
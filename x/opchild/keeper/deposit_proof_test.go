@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_MsgServer_FinalizeTokenDepositWithProof(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	newDeposit := func(sequence uint64, denom string, proof []byte) *types.MsgFinalizeTokenDepositWithProof {
+		return types.NewMsgFinalizeTokenDepositWithProof(
+			testutil.AddrsStr[3], testutil.AddrsStr[1], testutil.AddrsStr[2],
+			sdk.NewInt64Coin(denom, 100), sequence, 10, "test_token", nil,
+			proof, clienttypes.NewHeight(1, 11),
+		)
+	}
+
+	// empty proof
+	_, err := ms.FinalizeTokenDepositWithProof(ctx, newDeposit(1, denom, nil))
+	require.Error(t, err)
+
+	// bridge info not exists
+	_, err = ms.FinalizeTokenDepositWithProof(ctx, newDeposit(1, denom, []byte("proof")))
+	require.ErrorIs(t, err, types.ErrBridgeInfoNotExists)
+
+	err = input.OPChildKeeper.BridgeInfo.Set(ctx, types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: testutil.AddrsStr[0],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "07-tendermint-0",
+	})
+	require.NoError(t, err)
+
+	// denom is not derived from the base denom
+	_, err = ms.FinalizeTokenDepositWithProof(ctx, newDeposit(1, "l2/invalid", []byte("proof")))
+	require.ErrorIs(t, err, types.ErrInvalidDepositProof)
+
+	// invalid proof
+	_, err = ms.FinalizeTokenDepositWithProof(ctx, newDeposit(1, denom, []byte("proof")))
+	require.Error(t, err)
+
+	// finalized sequence is no op without the proof verification
+	_, err = input.OPChildKeeper.IncreaseNextL1Sequence(ctx)
+	require.NoError(t, err)
+	res, err := ms.FinalizeTokenDepositWithProof(ctx, newDeposit(1, denom, []byte("proof")))
+	require.NoError(t, err)
+	require.Equal(t, types.NOOP, res.Result)
+}
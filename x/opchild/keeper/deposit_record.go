@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// SetDepositRecord stores the deposit record with the index of the failed deposits.
+func (k Keeper) SetDepositRecord(ctx context.Context, record types.DepositRecord) error {
+	if err := k.DepositRecords.Set(ctx, record.Sequence, record); err != nil {
+		return err
+	}
+
+	if record.IsFailed() {
+		return k.FailedDeposits.Set(ctx, record.Sequence)
+	}
+
+	return nil
+}
+
+// GetDepositRecord returns the deposit record of the l1 sequence.
+func (k Keeper) GetDepositRecord(ctx context.Context, l1Sequence uint64) (types.DepositRecord, error) {
+	return k.DepositRecords.Get(ctx, l1Sequence)
+}
+
+// RemoveDepositRecord removes the deposit record with the index of the failed deposits.
+func (k Keeper) RemoveDepositRecord(ctx context.Context, l1Sequence uint64) error {
+	if err := k.DepositRecords.Remove(ctx, l1Sequence); err != nil {
+		return err
+	}
+
+	return k.FailedDeposits.Remove(ctx, l1Sequence)
+}
+
+// PruneDepositRecords removes the deposit records older than the deposit record retention.
+// This should be called in EndBlocker.
+func (k Keeper) PruneDepositRecords(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if params.DepositRecordRetention == 0 || height <= params.DepositRecordRetention {
+		return nil
+	}
+
+	// the deposits are finalized in the order of the l1 sequence, so the records are ordered
+	// by the height as well
+	pruneHeight := height - params.DepositRecordRetention
+	var l1Sequences []uint64
+	if err := k.DepositRecords.Walk(ctx, nil, func(l1Sequence uint64, record types.DepositRecord) (stop bool, err error) {
+		if record.Height > pruneHeight {
+			return true, nil
+		}
+
+		l1Sequences = append(l1Sequences, l1Sequence)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, l1Sequence := range l1Sequences {
+		if err := k.RemoveDepositRecord(ctx, l1Sequence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
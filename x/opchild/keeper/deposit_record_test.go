@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+func Test_DepositRecords(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_).WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	querier := keeper.NewQuerier(&input.OPChildKeeper)
+
+	bz := sha3.Sum256([]byte("test_token"))
+	denom := "l2/" + hex.EncodeToString(bz[:])
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	// successful deposit
+	_, err := ms.FinalizeTokenDeposit(ctx, types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], testutil.AddrsStr[2], sdk.NewCoin(denom, math.NewInt(100)), 1, 1, "test_token", nil))
+	require.NoError(t, err)
+
+	// failed deposit to the module account, which is refunded
+	_, err = ms.FinalizeTokenDeposit(ctx, types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], moduleAddr, sdk.NewCoin(denom, math.NewInt(100)), 2, 1, "test_token", nil))
+	require.NoError(t, err)
+
+	// failed hook
+	_, err = ms.FinalizeTokenDeposit(ctx, types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], testutil.AddrsStr[2], sdk.NewCoin(denom, math.NewInt(100)), 3, 1, "test_token", []byte{1, 2, 3}))
+	require.NoError(t, err)
+
+	res, err := querier.Deposit(ctx, &types.QueryDepositRequest{Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.DepositRecord{
+		Sequence:  1,
+		From:      testutil.AddrsStr[1],
+		To:        testutil.AddrsStr[2],
+		Amount:    sdk.NewCoin(denom, math.NewInt(100)),
+		BaseDenom: "test_token",
+		Success:   true,
+		Height:    10,
+	}, res.Deposit)
+
+	res, err = querier.Deposit(ctx, &types.QueryDepositRequest{Sequence: 2})
+	require.NoError(t, err)
+	require.False(t, res.Deposit.Success)
+	require.Contains(t, res.Deposit.Reason, "failed to send coins")
+	require.False(t, res.Deposit.HookExecuted)
+	require.Equal(t, uint64(1), res.Deposit.RefundL2Sequence)
+
+	res, err = querier.Deposit(ctx, &types.QueryDepositRequest{Sequence: 3})
+	require.NoError(t, err)
+	require.True(t, res.Deposit.Success)
+	require.True(t, res.Deposit.HookExecuted)
+	require.False(t, res.Deposit.HookSuccess)
+	require.Contains(t, res.Deposit.HookReason, "Failed to decode tx")
+	require.Zero(t, res.Deposit.RefundL2Sequence)
+
+	_, err = querier.Deposit(ctx, &types.QueryDepositRequest{Sequence: 5})
+	require.Error(t, err)
+
+	failed, err := querier.FailedDeposits(ctx, &types.QueryFailedDepositsRequest{})
+	require.NoError(t, err)
+	require.Len(t, failed.Deposits, 2)
+	require.Equal(t, uint64(2), failed.Deposits[0].Sequence)
+	require.Equal(t, uint64(3), failed.Deposits[1].Sequence)
+
+	// a deposit at the later block
+	ctx = ctx.WithBlockHeight(12)
+	_, err = ms.FinalizeTokenDeposit(ctx, types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], moduleAddr, sdk.NewCoin(denom, math.NewInt(100)), 4, 1, "test_token", nil))
+	require.NoError(t, err)
+
+	// keep the records of the last 2 blocks
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.DepositRecordRetention = 2
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(13)
+	require.NoError(t, input.OPChildKeeper.PruneDepositRecords(ctx))
+
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		_, err = querier.Deposit(ctx, &types.QueryDepositRequest{Sequence: sequence})
+		require.Error(t, err)
+	}
+	_, err = querier.Deposit(ctx, &types.QueryDepositRequest{Sequence: 4})
+	require.NoError(t, err)
+
+	failed, err = querier.FailedDeposits(ctx, &types.QueryFailedDepositsRequest{})
+	require.NoError(t, err)
+	require.Len(t, failed.Deposits, 1)
+	require.Equal(t, uint64(4), failed.Deposits[0].Sequence)
+}
@@ -10,10 +10,12 @@ import (
 	"cosmossdk.io/math"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/initia-labs/OPinit/x/opchild/keeper"
 	"github.com/initia-labs/OPinit/x/opchild/testutil"
 	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 func Test_MsgServer_Deposit_HookEvents(t *testing.T) {
@@ -66,3 +68,79 @@ func Test_MsgServer_Deposit_HookEvents(t *testing.T) {
 	// but no event was emitted by hooks
 	require.True(t, withdrawalEventFound)
 }
+
+func Test_MsgServer_Deposit_IBCTransferRoute(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	bz := sha3.Sum256([]byte("test_token"))
+	denom := "l2/" + hex.EncodeToString(bz[:])
+
+	data := []byte(`{"route":{"ibc_transfer":{"source_port":"transfer","source_channel":"channel-0","receiver":"cosmos1receiver","memo":"forward"}}}`)
+	msg := types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], testutil.AddrsStr[2], sdk.NewCoin(denom, math.NewInt(100)), 1, 1, "test_token", data)
+	_, err := ms.FinalizeTokenDeposit(ctx, msg)
+	require.NoError(t, err)
+
+	// the deposited tokens are forwarded from the recipient
+	require.Equal(t, math.ZeroInt(), input.BankKeeper.GetBalance(ctx, testutil.Addrs[2], denom).Amount)
+
+	handledMsgs := input.MockRouter.GetHandledMsgs()
+	require.Len(t, handledMsgs, 1)
+	require.Equal(t, testutil.AddrsStr[2], handledMsgs[0].Sender)
+	require.Equal(t, "cosmos1receiver", handledMsgs[0].Receiver)
+	require.Equal(t, "channel-0", handledMsgs[0].SourceChannel)
+	require.Equal(t, "forward", handledMsgs[0].Memo)
+	require.Equal(t, sdk.NewCoin(denom, math.NewInt(100)), handledMsgs[0].Token)
+
+	// failed route keeps the deposited tokens in the recipient
+	input.MockRouter.SetShouldFail(true)
+	ctx = sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+	msg = types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], testutil.AddrsStr[2], sdk.NewCoin(denom, math.NewInt(100)), 2, 1, "test_token", data)
+	_, err = ms.FinalizeTokenDeposit(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, testutil.Addrs[2], denom).Amount)
+
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().Events() {
+		if event.Type == types.EventTypeFinalizeTokenDeposit {
+			require.Equal(t, types.AttributeKeyReason, event.Attributes[len(event.Attributes)-1].Key)
+			require.Contains(t, event.Attributes[len(event.Attributes)-1].Value, "Failed to handle deposit route")
+		}
+	}
+}
+
+func Test_MsgServer_Deposit_ContractCallRoute(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	bz := sha3.Sum256([]byte("test_token"))
+	denom := "l2/" + hex.EncodeToString(bz[:])
+
+	// deposit to the intermediate sender, then send the tokens by the contract call
+	sender := ophosttypes.DepositRouteIntermediateSender(testutil.AddrsStr[1])
+	senderStr, err := input.AccountKeeper.AddressCodec().BytesToString(sender)
+	require.NoError(t, err)
+
+	sendMsg, err := input.Cdc.MarshalInterfaceJSON(banktypes.NewMsgSend(sender, testutil.Addrs[3], sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(40)))))
+	require.NoError(t, err)
+
+	data := []byte(`{"route":{"contract_call":{"msgs":[` + string(sendMsg) + `]}}}`)
+	msg := types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], senderStr, sdk.NewCoin(denom, math.NewInt(100)), 1, 1, "test_token", data)
+	_, err = ms.FinalizeTokenDeposit(ctx, msg)
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(60), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+	require.Equal(t, math.NewInt(40), input.BankKeeper.GetBalance(ctx, testutil.Addrs[3], denom).Amount)
+
+	// cannot execute the message on behalf of the other accounts
+	sendMsg, err = input.Cdc.MarshalInterfaceJSON(banktypes.NewMsgSend(testutil.Addrs[3], testutil.Addrs[4], sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(40)))))
+	require.NoError(t, err)
+
+	data = []byte(`{"route":{"contract_call":{"msgs":[` + string(sendMsg) + `]}}}`)
+	msg = types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], senderStr, sdk.NewCoin(denom, math.NewInt(100)), 2, 1, "test_token", data)
+	_, err = ms.FinalizeTokenDeposit(ctx, msg)
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(160), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+	require.Equal(t, math.NewInt(40), input.BankKeeper.GetBalance(ctx, testutil.Addrs[3], denom).Amount)
+	require.Equal(t, math.ZeroInt(), input.BankKeeper.GetBalance(ctx, testutil.Addrs[4], denom).Amount)
+}
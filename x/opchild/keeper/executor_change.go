@@ -2,59 +2,194 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/initia-labs/OPinit/x/opchild/types"
 )
 
+// RegisterExecutorChangePlan registers the executor change plan with the JSON encoded
+// consensus pubkey from an upgrade handler at the app construction, where no context is
+// available. The plan is kept in memory and persisted at the next end block; a plan which
+// is already stored is ignored at that time, and a plan whose height has already passed is
+// logged and dropped, as upgrade handlers register their plans again at every restart. Use
+// ScheduleExecutorChangePlan to get an error for a past height.
 func (k Keeper) RegisterExecutorChangePlan(
 	proposalID, height uint64,
 	nextValidator, moniker, consensusPubKey, info string, nextExecutors []string,
 ) error {
-	if proposalID <= 0 {
-		return errorsmod.Wrap(types.ErrInvalidExecutorChangePlan, "invalid proposal id")
+	plan, err := k.newExecutorChangePlan(proposalID, height, nextValidator, moniker, consensusPubKey, info, nextExecutors)
+	if err != nil {
+		return err
 	}
 
-	if height <= 0 {
-		return errorsmod.Wrap(types.ErrInvalidExecutorChangePlan, "invalid height")
+	if err := plan.Validate(k.addressCodec, k.validatorAddressCodec); err != nil {
+		return err
 	}
 
 	if _, found := k.ExecutorChangePlans[height]; found {
 		return types.ErrAlreadyRegisteredHeight
 	}
 
-	valAddr, err := k.validatorAddressCodec.StringToBytes(nextValidator)
+	k.ExecutorChangePlans[height] = plan
+	return nil
+}
+
+// ScheduleExecutorChangePlan stores the executor change plan with the JSON encoded
+// consensus pubkey in the state. It returns an error if the height has already passed.
+func (k Keeper) ScheduleExecutorChangePlan(
+	ctx context.Context,
+	proposalID, height uint64,
+	nextValidator, moniker, consensusPubKey, info string, nextExecutors []string,
+) error {
+	plan, err := k.newExecutorChangePlan(proposalID, height, nextValidator, moniker, consensusPubKey, info, nextExecutors)
 	if err != nil {
 		return err
 	}
-	for _, nextExecutor := range nextExecutors {
-		_, err = k.addressCodec.StringToBytes(nextExecutor)
-		if err != nil {
-			return err
-		}
+
+	return k.SetExecutorChangePlan(ctx, plan)
+}
+
+func (k Keeper) newExecutorChangePlan(
+	proposalID, height uint64,
+	nextValidator, moniker, consensusPubKey, info string, nextExecutors []string,
+) (types.ExecutorChangePlan, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(nextValidator)
+	if err != nil {
+		return types.ExecutorChangePlan{}, err
 	}
 
 	var pubKey cryptotypes.PubKey
 	err = k.cdc.UnmarshalInterfaceJSON([]byte(consensusPubKey), &pubKey)
 	if err != nil {
-		return errorsmod.Wrap(types.ErrInvalidExecutorChangePlan, "invalid pub key")
+		return types.ExecutorChangePlan{}, errorsmod.Wrap(types.ErrInvalidExecutorChangePlan, "invalid pub key")
 	}
 
 	validator, err := types.NewValidator(valAddr, pubKey, moniker)
 	if err != nil {
-		return err
+		return types.ExecutorChangePlan{}, err
 	}
 
-	k.ExecutorChangePlans[height] = types.ExecutorChangePlan{
+	return types.ExecutorChangePlan{
 		ProposalID:    proposalID,
 		Height:        height,
 		NextExecutors: nextExecutors,
 		NextValidator: validator,
 		Info:          info,
+	}, nil
+}
+
+// persistRegisteredExecutorChangePlans moves the executor change plans registered without
+// context to the state.
+func (k Keeper) persistRegisteredExecutorChangePlans(ctx context.Context) error {
+	if len(k.ExecutorChangePlans) == 0 {
+		return nil
+	}
+
+	heights := make([]uint64, 0, len(k.ExecutorChangePlans))
+	for height := range k.ExecutorChangePlans {
+		heights = append(heights, height)
+	}
+	slices.Sort(heights)
+
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	for _, height := range heights {
+		if height < currentHeight {
+			k.Logger(ctx).Error("dropped executor change plan at a past height",
+				"proposal_id", k.ExecutorChangePlans[height].ProposalID,
+				"height", height,
+				"current_height", currentHeight,
+			)
+			continue
+		}
+
+		if found, err := k.ExecutorChanges.Has(ctx, height); err != nil {
+			return err
+		} else if found {
+			continue
+		}
+
+		if err := k.ExecutorChanges.Set(ctx, height, k.ExecutorChangePlans[height]); err != nil {
+			return err
+		}
+	}
+
+	clear(k.ExecutorChangePlans)
+	return nil
+}
+
+// SetExecutorChangePlan stores the executor change plan. Only one plan can be scheduled at
+// the same height, and the height must not have passed.
+func (k Keeper) SetExecutorChangePlan(ctx context.Context, plan types.ExecutorChangePlan) error {
+	if err := plan.Validate(k.addressCodec, k.validatorAddressCodec); err != nil {
+		return err
+	}
+
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if plan.Height < currentHeight {
+		return errorsmod.Wrapf(types.ErrInvalidExecutorChangePlan, "height %d has already passed; current height %d", plan.Height, currentHeight)
+	}
+
+	if found, err := k.ExecutorChanges.Has(ctx, plan.Height); err != nil {
+		return err
+	} else if found {
+		return types.ErrAlreadyRegisteredHeight
+	}
+
+	return k.ExecutorChanges.Set(ctx, plan.Height, plan)
+}
+
+// GetExecutorChangePlans returns all the scheduled executor change plans in the order of height.
+func (k Keeper) GetExecutorChangePlans(ctx context.Context) ([]types.ExecutorChangePlan, error) {
+	plans := []types.ExecutorChangePlan{}
+	err := k.ExecutorChanges.Walk(ctx, nil, func(_ uint64, plan types.ExecutorChangePlan) (stop bool, err error) {
+		plans = append(plans, plan)
+		return false, nil
+	})
+
+	return plans, err
+}
+
+// ApplyExecutorChangePlan applies the executor change plan scheduled at the current height,
+// and removes it from the store.
+func (k Keeper) ApplyExecutorChangePlan(ctx context.Context) error {
+	if err := k.persistRegisteredExecutorChangePlans(ctx); err != nil {
+		return err
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight()) //nolint:gosec
+
+	plan, err := k.ExecutorChanges.Get(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.ChangeExecutor(ctx, plan); err != nil {
+		return err
+	}
+
+	if err := k.ExecutorChanges.Remove(ctx, height); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChangeExecutor,
+		sdk.NewAttribute(types.AttributeKeyProposalId, strconv.FormatUint(plan.ProposalID, 10)),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(plan.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyValidator, plan.NextValidator.OperatorAddress),
+		sdk.NewAttribute(types.AttributeKeyNextExecutors, strings.Join(plan.NextExecutors, ",")),
+	))
+
 	return nil
 }
 
@@ -68,6 +203,11 @@ func (k Keeper) ChangeExecutor(ctx context.Context, plan types.ExecutorChangePla
 		return err
 	}
 
+	// the next validator replaces the whole sequencer set
+	if err := k.Sequencers.Clear(ctx, nil); err != nil {
+		return err
+	}
+
 	if err := k.SetValidator(ctx, plan.NextValidator); err != nil {
 		return err
 	}
@@ -10,16 +10,19 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
 	"github.com/initia-labs/OPinit/x/opchild/testutil"
 	"github.com/initia-labs/OPinit/x/opchild/types"
 )
 
 func Test_RegisterExecutorChangePlan(t *testing.T) {
 	// Setup
-	_, input := testutil.CreateTestInput(t, false)
+	ctx, input := testutil.CreateTestInput(t, false)
 
 	// Arguments
 	l1ProposalID, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
@@ -40,7 +43,16 @@ func Test_RegisterExecutorChangePlan(t *testing.T) {
 		nextExecutorAddr,
 	)
 	require.NoError(t, err)
-	require.Len(t, input.OPChildKeeper.ExecutorChangePlans, 1)
+
+	// the plan registered without context is persisted at the end block
+	plans, err := input.OPChildKeeper.GetExecutorChangePlans(ctx)
+	require.NoError(t, err)
+	require.Empty(t, plans)
+
+	require.NoError(t, input.OPChildKeeper.ApplyExecutorChangePlan(ctx))
+	plans, err = input.OPChildKeeper.GetExecutorChangePlans(ctx)
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
 
 	consensusPubKeyBytes, err := base64.StdEncoding.DecodeString(consensusPubKey)
 	require.NoError(t, err)
@@ -55,7 +67,7 @@ func Test_RegisterExecutorChangePlan(t *testing.T) {
 		NextExecutors: []string{testutil.AddrsStr[0], testutil.AddrsStr[1]},
 		NextValidator: expectedValidator,
 		Info:          info,
-	}, input.OPChildKeeper.ExecutorChangePlans[height.Uint64()])
+	}, plans[0])
 }
 
 func Test_ExecuteChangePlan(t *testing.T) {
@@ -85,17 +97,19 @@ func Test_ExecuteChangePlan(t *testing.T) {
 	moniker := "moniker"
 	info := "info"
 
-	err = input.OPChildKeeper.RegisterExecutorChangePlan(
-		l1ProposalID.Uint64(), height.Uint64(), nextValAddr,
+	err = input.OPChildKeeper.ScheduleExecutorChangePlan(
+		ctx, l1ProposalID.Uint64(), height.Uint64(), nextValAddr,
 		moniker,
 		fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`, consensusPubKey),
 		info,
 		nextExecutorAddr,
 	)
 	require.NoError(t, err)
-	require.Len(t, input.OPChildKeeper.ExecutorChangePlans, 1)
 
-	err = input.OPChildKeeper.ChangeExecutor(ctx, input.OPChildKeeper.ExecutorChangePlans[height.Uint64()])
+	plan, err := input.OPChildKeeper.ExecutorChanges.Get(ctx, height.Uint64())
+	require.NoError(t, err)
+
+	err = input.OPChildKeeper.ChangeExecutor(ctx, plan)
 	require.NoError(t, err)
 
 	// Check if the validator has been updated
@@ -119,3 +133,93 @@ func Test_ExecuteChangePlan(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, int64(0), validator.ConsPower)
 }
+
+func Test_MsgServer_ScheduleExecutorChange(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_).WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	q := keeper.NewQuerier(&input.OPChildKeeper)
+
+	govAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	valPubKeys := testutilsims.CreateTestPubKeys(1)
+	nextExecutors := []string{testutil.AddrsStr[1], testutil.AddrsStr[2]}
+
+	// unauthorized
+	msg, err := types.NewMsgScheduleExecutorChange(testutil.AddrsStr[1], 1, 20, "next", testutil.ValAddrsStr[0], valPubKeys[0], nextExecutors, "info")
+	require.NoError(t, err)
+	_, err = ms.ScheduleExecutorChange(ctx, msg)
+	require.Error(t, err)
+
+	// past height
+	msg, err = types.NewMsgScheduleExecutorChange(govAddr, 1, 10, "next", testutil.ValAddrsStr[0], valPubKeys[0], nextExecutors, "info")
+	require.NoError(t, err)
+	_, err = ms.ScheduleExecutorChange(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidExecutorChangePlan)
+
+	// schedule by gov and admin
+	msg, err = types.NewMsgScheduleExecutorChange(govAddr, 1, 20, "next", testutil.ValAddrsStr[0], valPubKeys[0], nextExecutors, "info")
+	require.NoError(t, err)
+	_, err = ms.ScheduleExecutorChange(ctx, msg)
+	require.NoError(t, err)
+
+	msg, err = types.NewMsgScheduleExecutorChange(testutil.AddrsStr[0], 2, 30, "next", testutil.ValAddrsStr[0], valPubKeys[0], nextExecutors, "info")
+	require.NoError(t, err)
+	_, err = ms.ScheduleExecutorChange(ctx, msg)
+	require.NoError(t, err)
+
+	// duplicate height
+	_, err = ms.ScheduleExecutorChange(ctx, msg)
+	require.ErrorIs(t, err, types.ErrAlreadyRegisteredHeight)
+
+	res, err := q.ScheduledExecutorChanges(ctx, &types.QueryScheduledExecutorChangesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Plans, 2)
+	require.Equal(t, uint64(20), res.Plans[0].Height)
+	require.Equal(t, uint64(30), res.Plans[1].Height)
+
+	// cancel the second plan
+	_, err = ms.CancelExecutorChange(ctx, types.NewMsgCancelExecutorChange(govAddr, 30))
+	require.NoError(t, err)
+	_, err = ms.CancelExecutorChange(ctx, types.NewMsgCancelExecutorChange(govAddr, 30))
+	require.ErrorIs(t, err, types.ErrExecutorChangePlanNotFound)
+
+	// apply the first plan at its height
+	require.NoError(t, input.OPChildKeeper.ApplyExecutorChangePlan(ctx.WithBlockHeight(19)))
+	require.NoError(t, input.OPChildKeeper.ApplyExecutorChangePlan(ctx.WithBlockHeight(20)))
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, nextExecutors, params.BridgeExecutors)
+
+	validator, found := input.OPChildKeeper.GetValidator(ctx, testutil.ValAddrs[0])
+	require.True(t, found)
+	require.Equal(t, "next", validator.GetMoniker())
+
+	res, err = q.ScheduledExecutorChanges(ctx, &types.QueryScheduledExecutorChangesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Plans)
+}
+
+func Test_ScheduleExecutorChangePlan_PastHeight(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_).WithBlockHeight(10)
+
+	pubKey := `{"@type":"/cosmos.crypto.ed25519.PubKey","key":"l7aqGv+Zjbm0rallfqfqz+3iN31iOmgJCafWV5pGs6o="}`
+	nextExecutors := []string{testutil.AddrsStr[0]}
+
+	err := input.OPChildKeeper.ScheduleExecutorChangePlan(ctx, 1, 9, testutil.ValAddrsStr[0], "moniker", pubKey, "info", nextExecutors)
+	require.ErrorIs(t, err, types.ErrInvalidExecutorChangePlan)
+
+	// the plans registered without context at a past height are not persisted
+	require.NoError(t, input.OPChildKeeper.RegisterExecutorChangePlan(1, 9, testutil.ValAddrsStr[0], "moniker", pubKey, "info", nextExecutors))
+	require.NoError(t, input.OPChildKeeper.RegisterExecutorChangePlan(2, 11, testutil.ValAddrsStr[0], "moniker", pubKey, "info", nextExecutors))
+	require.NoError(t, input.OPChildKeeper.ApplyExecutorChangePlan(ctx))
+	require.Empty(t, input.OPChildKeeper.ExecutorChangePlans)
+
+	plans, err := input.OPChildKeeper.GetExecutorChangePlans(ctx)
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	require.Equal(t, uint64(11), plans[0].Height)
+}
@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// voteDeposit records the vote of the bridge executor on the deposit payload, and returns true
+// if the deposit can be executed. The votes of the sequence are cleared once the threshold of
+// the identical payload is reached, and the remaining votes are cleared when the deposit is
+// finalized. Only the votes within the pending deposit window are recorded.
+func (ms MsgServer) voteDeposit(ctx context.Context, req *types.MsgFinalizeTokenDeposit, nextL1Sequence uint64) (bool, error) {
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return false, err
+	} else if params.BridgeExecutorThreshold <= 1 {
+		return true, nil
+	}
+
+	if req.Sequence < nextL1Sequence || req.Sequence > nextL1Sequence+params.MaxPendingDeposits {
+		return false, errorsmod.Wrapf(
+			types.ErrInvalidSequence,
+			"sequence %d is out of the pending deposit window; next l1 sequence %d, max pending deposits %d",
+			req.Sequence, nextL1Sequence, params.MaxPendingDeposits,
+		)
+	}
+
+	voter, err := ms.authKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return false, err
+	}
+
+	payloadHash, err := req.PayloadHash()
+	if err != nil {
+		return false, err
+	}
+
+	if err := ms.DepositVotes.Set(ctx, collections.Join(req.Sequence, voter), payloadHash); err != nil {
+		return false, err
+	}
+
+	executors, err := ms.bridgeExecutorSet(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// count the identical votes of the current bridge executors
+	votes := uint64(0)
+	err = ms.DepositVotes.Walk(ctx, collections.NewPrefixedPairRange[uint64, []byte](req.Sequence), func(key collections.Pair[uint64, []byte], hash []byte) (stop bool, err error) {
+		if executors[string(key.K2())] && bytes.Equal(hash, payloadHash) {
+			votes++
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeExecutorVote,
+		sdk.NewAttribute(types.AttributeKeyMsgType, sdk.MsgTypeURL(req)),
+		sdk.NewAttribute(types.AttributeKeyVoter, req.Sender),
+		sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(req.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyPayloadHash, hex.EncodeToString(payloadHash)),
+		sdk.NewAttribute(types.AttributeKeyVotes, strconv.FormatUint(votes, 10)),
+		sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(params.BridgeExecutorThreshold, 10)),
+	))
+
+	if votes < params.BridgeExecutorThreshold {
+		return false, nil
+	}
+
+	if err := ms.clearDepositVotes(ctx, req.Sequence); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// clearDepositVotes removes all the votes on the deposit of the l1 sequence.
+func (k Keeper) clearDepositVotes(ctx context.Context, sequence uint64) error {
+	return k.DepositVotes.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](sequence))
+}
+
+// voteBridgeInfo records the vote of the bridge executor on the bridge info payload, and returns
+// true if the bridge info can be set. The votes are cleared once the threshold of the identical
+// payload is reached.
+func (ms MsgServer) voteBridgeInfo(ctx context.Context, req *types.MsgSetBridgeInfo) (bool, error) {
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return false, err
+	} else if params.BridgeExecutorThreshold <= 1 {
+		return true, nil
+	}
+
+	voter, err := ms.authKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return false, err
+	}
+
+	payloadHash, err := req.PayloadHash()
+	if err != nil {
+		return false, err
+	}
+
+	if err := ms.BridgeInfoVotes.Set(ctx, voter, payloadHash); err != nil {
+		return false, err
+	}
+
+	executors, err := ms.bridgeExecutorSet(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// count the identical votes of the current bridge executors
+	var voteKeys [][]byte
+	votes := uint64(0)
+	err = ms.BridgeInfoVotes.Walk(ctx, nil, func(key []byte, hash []byte) (stop bool, err error) {
+		voteKeys = append(voteKeys, key)
+		if executors[string(key)] && bytes.Equal(hash, payloadHash) {
+			votes++
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeExecutorVote,
+		sdk.NewAttribute(types.AttributeKeyMsgType, sdk.MsgTypeURL(req)),
+		sdk.NewAttribute(types.AttributeKeyVoter, req.Sender),
+		sdk.NewAttribute(types.AttributeKeyPayloadHash, hex.EncodeToString(payloadHash)),
+		sdk.NewAttribute(types.AttributeKeyVotes, strconv.FormatUint(votes, 10)),
+		sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(params.BridgeExecutorThreshold, 10)),
+	))
+
+	if votes < params.BridgeExecutorThreshold {
+		return false, nil
+	}
+
+	for _, key := range voteKeys {
+		if err := ms.BridgeInfoVotes.Remove(ctx, key); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// pruneBridgeExecutorVotes removes the deposit and bridge info votes of the accounts which
+// are no longer bridge executors.
+func (k Keeper) pruneBridgeExecutorVotes(ctx context.Context) error {
+	executors, err := k.bridgeExecutorSet(ctx)
+	if err != nil {
+		return err
+	}
+
+	var depositVoteKeys []collections.Pair[uint64, []byte]
+	err = k.DepositVotes.Walk(ctx, nil, func(key collections.Pair[uint64, []byte], _ []byte) (stop bool, err error) {
+		if !executors[string(key.K2())] {
+			depositVoteKeys = append(depositVoteKeys, key)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range depositVoteKeys {
+		if err := k.DepositVotes.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	var bridgeInfoVoteKeys [][]byte
+	err = k.BridgeInfoVotes.Walk(ctx, nil, func(key []byte, _ []byte) (stop bool, err error) {
+		if !executors[string(key)] {
+			bridgeInfoVoteKeys = append(bridgeInfoVoteKeys, key)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range bridgeInfoVoteKeys {
+		if err := k.BridgeInfoVotes.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bridgeExecutorSet returns the set of the current bridge executor addresses.
+func (k Keeper) bridgeExecutorSet(ctx context.Context) (map[string]bool, error) {
+	bridgeExecutors, err := k.BridgeExecutors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	executors := make(map[string]bool, len(bridgeExecutors))
+	for _, executor := range bridgeExecutors {
+		executors[string(executor)] = true
+	}
+
+	return executors, nil
+}
+
+// GetDepositVotes returns the bridge executor votes on the deposit of the l1 sequence.
+func (k Keeper) GetDepositVotes(ctx context.Context, sequence uint64) ([]types.BridgeExecutorVote, error) {
+	votes := []types.BridgeExecutorVote{}
+	err := k.DepositVotes.Walk(ctx, collections.NewPrefixedPairRange[uint64, []byte](sequence), func(key collections.Pair[uint64, []byte], hash []byte) (stop bool, err error) {
+		voter, err := k.authKeeper.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		votes = append(votes, types.BridgeExecutorVote{Voter: voter, PayloadHash: hash})
+		return false, nil
+	})
+
+	return votes, err
+}
+
+// GetBridgeInfoVotes returns the bridge executor votes on the bridge info.
+func (k Keeper) GetBridgeInfoVotes(ctx context.Context) ([]types.BridgeExecutorVote, error) {
+	votes := []types.BridgeExecutorVote{}
+	err := k.BridgeInfoVotes.Walk(ctx, nil, func(key []byte, hash []byte) (stop bool, err error) {
+		voter, err := k.authKeeper.AddressCodec().BytesToString(key)
+		if err != nil {
+			return true, err
+		}
+
+		votes = append(votes, types.BridgeExecutorVote{Voter: voter, PayloadHash: hash})
+		return false, nil
+	})
+
+	return votes, err
+}
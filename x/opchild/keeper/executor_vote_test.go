@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func setBridgeExecutorThreshold(t *testing.T, ctx sdk.Context, input testutil.TestKeepers, threshold uint64) {
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BridgeExecutors = []string{testutil.AddrsStr[0], testutil.AddrsStr[1], testutil.AddrsStr[2]}
+	params.BridgeExecutorThreshold = threshold
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+}
+
+func Test_MsgServer_Deposit_ExecutorQuorum(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	q := keeper.NewQuerier(&input.OPChildKeeper)
+
+	setBridgeExecutorThreshold(t, ctx, input, 2)
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	newDeposit := func(sender string, amount int64) *types.MsgFinalizeTokenDeposit {
+		return types.NewMsgFinalizeTokenDeposit(sender, testutil.AddrsStr[3], testutil.AddrsStr[4], sdk.NewInt64Coin(denom, amount), 1, 1, "test_token", nil)
+	}
+
+	res, err := ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[0], 100))
+	require.NoError(t, err)
+	require.Equal(t, types.VOTED, res.Result)
+
+	// the same executor cannot reach the threshold alone
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[0], 100))
+	require.NoError(t, err)
+	require.Equal(t, types.VOTED, res.Result)
+
+	// different payload
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[1], 1_000_000))
+	require.NoError(t, err)
+	require.Equal(t, types.VOTED, res.Result)
+	require.Equal(t, math.ZeroInt(), input.BankKeeper.GetBalance(ctx, testutil.Addrs[4], denom).Amount)
+
+	votes, err := q.DepositVotes(ctx, &types.QueryDepositVotesRequest{Sequence: 1})
+	require.NoError(t, err)
+	require.Len(t, votes.Votes, 2)
+	require.Equal(t, uint64(2), votes.Threshold)
+
+	// identical payload reaches the threshold
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[2], 100))
+	require.NoError(t, err)
+	require.Equal(t, types.SUCCESS, res.Result)
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, testutil.Addrs[4], denom).Amount)
+
+	// votes are cleared
+	votes, err = q.DepositVotes(ctx, &types.QueryDepositVotesRequest{Sequence: 1})
+	require.NoError(t, err)
+	require.Empty(t, votes.Votes)
+
+	// late vote is no op
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[1], 100))
+	require.NoError(t, err)
+	require.Equal(t, types.NOOP, res.Result)
+}
+
+func Test_MsgServer_Deposit_ExecutorQuorum_PendingDeposit(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	q := keeper.NewQuerier(&input.OPChildKeeper)
+
+	setBridgeExecutorThreshold(t, ctx, input, 2)
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxPendingDeposits = 10
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	newDeposit := func(sender string, sequence uint64) *types.MsgFinalizeTokenDeposit {
+		return types.NewMsgFinalizeTokenDeposit(sender, testutil.AddrsStr[3], testutil.AddrsStr[4], sdk.NewInt64Coin(denom, 100), sequence, 1, "test_token", nil)
+	}
+
+	// the vote out of the pending deposit window is not recorded
+	_, err = ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[0], 12))
+	require.ErrorIs(t, err, types.ErrInvalidSequence)
+
+	votes, err := q.DepositVotes(ctx, &types.QueryDepositVotesRequest{Sequence: 12})
+	require.NoError(t, err)
+	require.Empty(t, votes.Votes)
+
+	// the deposit ahead of the next l1 sequence is buffered once the threshold is reached
+	res, err := ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[0], 2))
+	require.NoError(t, err)
+	require.Equal(t, types.VOTED, res.Result)
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[1], 2))
+	require.NoError(t, err)
+	require.Equal(t, types.PENDING, res.Result)
+
+	// late vote on the buffered deposit is not recorded
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[2], 2))
+	require.NoError(t, err)
+	require.Equal(t, types.NOOP, res.Result)
+
+	votes, err = q.DepositVotes(ctx, &types.QueryDepositVotesRequest{Sequence: 2})
+	require.NoError(t, err)
+	require.Empty(t, votes.Votes)
+
+	// the votes left on the buffered sequence are cleared when it is finalized
+	require.NoError(t, input.OPChildKeeper.DepositVotes.Set(ctx, collections.Join(uint64(2), testutil.Addrs[2].Bytes()), []byte("hash")))
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[0], 1))
+	require.NoError(t, err)
+	require.Equal(t, types.VOTED, res.Result)
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(testutil.AddrsStr[1], 1))
+	require.NoError(t, err)
+	require.Equal(t, types.SUCCESS, res.Result)
+	require.Equal(t, math.NewInt(200), input.BankKeeper.GetBalance(ctx, testutil.Addrs[4], denom).Amount)
+
+	votes, err = q.DepositVotes(ctx, &types.QueryDepositVotesRequest{Sequence: 2})
+	require.NoError(t, err)
+	require.Empty(t, votes.Votes)
+}
+
+func Test_MsgServer_SetBridgeInfo_ExecutorQuorum(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	q := keeper.NewQuerier(&input.OPChildKeeper)
+
+	setBridgeExecutorThreshold(t, ctx, input, 2)
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: testutil.AddrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challenger: testutil.AddrsStr[2],
+			Proposer:   testutil.AddrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: testutil.AddrsStr[4],
+				ChainType: ophosttypes.BatchInfo_INITIA,
+			},
+			SubmissionInterval:    time.Minute,
+			FinalizationPeriod:    time.Hour,
+			SubmissionStartHeight: 1,
+		},
+	}
+
+	_, err := ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(testutil.AddrsStr[0], info))
+	require.NoError(t, err)
+
+	_, err = input.OPChildKeeper.BridgeInfo.Get(ctx)
+	require.Error(t, err)
+
+	votes, err := q.BridgeInfoVotes(ctx, &types.QueryBridgeInfoVotesRequest{})
+	require.NoError(t, err)
+	require.Len(t, votes.Votes, 1)
+	require.Equal(t, testutil.AddrsStr[0], votes.Votes[0].Voter)
+
+	_, err = ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(testutil.AddrsStr[1], info))
+	require.NoError(t, err)
+
+	_info, err := input.OPChildKeeper.BridgeInfo.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, info, _info)
+
+	votes, err = q.BridgeInfoVotes(ctx, &types.QueryBridgeInfoVotesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, votes.Votes)
+}
+
+func Test_Params_PruneRemovedExecutorVotes(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	q := keeper.NewQuerier(&input.OPChildKeeper)
+
+	setBridgeExecutorThreshold(t, ctx, input, 2)
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	for _, sender := range []string{testutil.AddrsStr[0], testutil.AddrsStr[2]} {
+		res, err := ms.FinalizeTokenDeposit(ctx, types.NewMsgFinalizeTokenDeposit(sender, testutil.AddrsStr[3], testutil.AddrsStr[4], sdk.NewInt64Coin(denom, 100), 1, 1, "test_token", []byte(sender)))
+		require.NoError(t, err)
+		require.Equal(t, types.VOTED, res.Result)
+	}
+	require.NoError(t, input.OPChildKeeper.BridgeInfoVotes.Set(ctx, testutil.Addrs[0].Bytes(), []byte("hash")))
+	require.NoError(t, input.OPChildKeeper.BridgeInfoVotes.Set(ctx, testutil.Addrs[2].Bytes(), []byte("hash")))
+
+	// remove the third executor
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BridgeExecutors = []string{testutil.AddrsStr[0], testutil.AddrsStr[1]}
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	votes, err := q.DepositVotes(ctx, &types.QueryDepositVotesRequest{Sequence: 1})
+	require.NoError(t, err)
+	require.Len(t, votes.Votes, 1)
+	require.Equal(t, testutil.AddrsStr[0], votes.Votes[0].Voter)
+
+	infoVotes, err := q.BridgeInfoVotes(ctx, &types.QueryBridgeInfoVotesRequest{})
+	require.NoError(t, err)
+	require.Len(t, infoVotes.Votes, 1)
+	require.Equal(t, testutil.AddrsStr[0], infoVotes.Votes[0].Voter)
+}
+
+func Test_Params_InvalidExecutorThreshold(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BridgeExecutors = []string{testutil.AddrsStr[0]}
+	params.BridgeExecutorThreshold = 2
+	require.ErrorIs(t, input.OPChildKeeper.SetParams(ctx, params), types.ErrInvalidExecutorThreshold)
+}
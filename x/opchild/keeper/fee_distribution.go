@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// GetFeeDistributionTotal returns the accumulated fees distributed to the recipient.
+func (k Keeper) GetFeeDistributionTotal(ctx context.Context, recipient string) (types.FeeDistributionTotal, error) {
+	total, err := k.FeeDistributions.Get(ctx, recipient)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.FeeDistributionTotal{Recipient: recipient, Amount: sdk.NewCoins()}, nil
+	}
+
+	return total, err
+}
+
+// DistributeFees distributes the fees collected in the fee collector by params.FeeSplits every
+// params.FeeDistributionInterval blocks. The shares are computed from the balance of the fee
+// collector before the distribution, and the rest of the fees is kept in the fee collector.
+func (k Keeper) DistributeFees(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if params.FeeDistributionInterval == 0 || len(params.FeeSplits) == 0 ||
+		uint64(sdkCtx.BlockHeight())%params.FeeDistributionInterval != 0 { //nolint:gosec
+		return nil
+	}
+
+	collected := k.bankKeeper.GetAllBalances(ctx, k.authKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+	if collected.IsZero() {
+		return nil
+	}
+
+	for _, split := range params.FeeSplits {
+		share, _ := sdk.NewDecCoinsFromCoins(collected...).MulDecTruncate(split.Ratio).TruncateDecimal()
+		if share.IsZero() {
+			continue
+		}
+
+		switch split.Recipient {
+		case types.FeeRecipientBurn:
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, share); err != nil {
+				return err
+			}
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, share); err != nil {
+				return err
+			}
+			if err := k.recordFeeDistribution(ctx, types.FeeRecipientBurn, share); err != nil {
+				return err
+			}
+		case types.FeeRecipientSequencers:
+			operators, err := k.sequencerOperators(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := k.distributeFeesToOperators(ctx, operators, share); err != nil {
+				return err
+			}
+		case types.FeeRecipientAttestors:
+			operators, err := k.attestorOperators(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := k.distributeFeesToOperators(ctx, operators, share); err != nil {
+				return err
+			}
+		default:
+			recipient, err := k.authKeeper.AddressCodec().StringToBytes(split.Recipient)
+			if err != nil {
+				return err
+			}
+
+			if err := k.sendDistributedFees(ctx, recipient, share); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// sequencerOperators returns the operators of the registered sequencers, or of the validators with
+// SequencerConsPower on the chains running a single sequencer without the sequencer set.
+func (k Keeper) sequencerOperators(ctx context.Context) ([]sdk.AccAddress, error) {
+	sequencers, err := k.GetSequencers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operators := make([]sdk.AccAddress, 0, len(sequencers))
+	for _, sequencer := range sequencers {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(sequencer.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		operators = append(operators, sdk.AccAddress(valAddr))
+	}
+
+	if len(operators) > 0 {
+		return operators, nil
+	}
+
+	validators, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, validator := range validators {
+		if validator.ConsPower != types.SequencerConsPower {
+			continue
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		operators = append(operators, sdk.AccAddress(valAddr))
+	}
+
+	return operators, nil
+}
+
+// attestorOperators returns the operators of the attestor set relayed from L1.
+func (k Keeper) attestorOperators(ctx context.Context) ([]sdk.AccAddress, error) {
+	bridgeInfo, err := k.BridgeInfo.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	operators := make([]sdk.AccAddress, 0, len(bridgeInfo.BridgeConfig.AttestorSet))
+	for _, attestor := range bridgeInfo.BridgeConfig.AttestorSet {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(attestor.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		operators = append(operators, sdk.AccAddress(valAddr))
+	}
+
+	return operators, nil
+}
+
+// distributeFeesToOperators divides the fees equally among the operators. The remainder of the
+// division is kept in the fee collector.
+func (k Keeper) distributeFeesToOperators(ctx context.Context, operators []sdk.AccAddress, fees sdk.Coins) error {
+	if len(operators) == 0 {
+		return nil
+	}
+
+	share := sdk.NewCoins()
+	for _, fee := range fees {
+		share = share.Add(sdk.NewCoin(fee.Denom, fee.Amount.QuoRaw(int64(len(operators)))))
+	}
+	if share.IsZero() {
+		return nil
+	}
+
+	for _, operator := range operators {
+		if err := k.sendDistributedFees(ctx, operator, share); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendDistributedFees sends the fees from the fee collector to the recipient and records them.
+func (k Keeper) sendDistributedFees(ctx context.Context, recipient sdk.AccAddress, fees sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, fees); err != nil {
+		return err
+	}
+
+	recipientStr, err := k.authKeeper.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return err
+	}
+
+	return k.recordFeeDistribution(ctx, recipientStr, fees)
+}
+
+// recordFeeDistribution accumulates the fees distributed to the recipient and emits the event.
+func (k Keeper) recordFeeDistribution(ctx context.Context, recipient string, fees sdk.Coins) error {
+	total, err := k.GetFeeDistributionTotal(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	total.Amount = total.Amount.Add(fees...)
+	if err := k.FeeDistributions.Set(ctx, recipient, total); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDistributeFees,
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, fees.String()),
+	))
+
+	return nil
+}
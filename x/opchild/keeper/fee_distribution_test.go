@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_DistributeFees(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	q := keeper.NewQuerier(&input.OPChildKeeper)
+
+	valPubKeys := testutilsims.CreateTestPubKeys(3)
+	require.NoError(t, input.OPChildKeeper.AddValidatorWithPower(ctx, "sequencer0", testutil.ValAddrs[0], valPubKeys[0], types.SequencerConsPower))
+	require.NoError(t, input.OPChildKeeper.AddValidatorWithPower(ctx, "sequencer1", testutil.ValAddrs[1], valPubKeys[1], types.SequencerConsPower))
+	require.NoError(t, input.OPChildKeeper.AddValidatorWithPower(ctx, "attestor", testutil.ValAddrs[2], valPubKeys[2], types.AttestorConsPower))
+
+	// the attestors are identified by the attestor set, not by the consensus power
+	require.NoError(t, input.OPChildKeeper.BridgeInfo.Set(ctx, types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: testutil.AddrsStr[0],
+		BridgeConfig: ophosttypes.BridgeConfig{
+			AttestorSet: []ophosttypes.Attestor{testutil.CreateAttestor(t, testutil.ValAddrsStr[2], valPubKeys[2], "attestor")},
+		},
+	}))
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// the ratios cannot exceed one in total
+	params.FeeDistributionInterval = 10
+	params.FeeSplits = []types.FeeSplit{
+		{Recipient: types.FeeRecipientSequencers, Ratio: math.LegacyNewDecWithPrec(6, 1)},
+		{Recipient: types.FeeRecipientBurn, Ratio: math.LegacyNewDecWithPrec(5, 1)},
+	}
+	require.ErrorIs(t, input.OPChildKeeper.SetParams(ctx, params), types.ErrInvalidFeeSplit)
+
+	params.FeeSplits = []types.FeeSplit{
+		{Recipient: types.FeeRecipientSequencers, Ratio: math.LegacyNewDecWithPrec(5, 1)},
+		{Recipient: types.FeeRecipientAttestors, Ratio: math.LegacyNewDecWithPrec(2, 1)},
+		{Recipient: testutil.AddrsStr[3], Ratio: math.LegacyNewDecWithPrec(1, 1)},
+		{Recipient: types.FeeRecipientBurn, Ratio: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	input.Faucet.Fund(ctx, feeCollector, sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1_000)))
+
+	balanceOf := func(addr sdk.AccAddress) math.Int {
+		return input.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+	beforeBalances := []math.Int{balanceOf(testutil.Addrs[0]), balanceOf(testutil.Addrs[1]), balanceOf(testutil.Addrs[2]), balanceOf(testutil.Addrs[3])}
+	beforeSupply := input.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+
+	// not the distribution height
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	require.NoError(t, input.OPChildKeeper.DistributeFees(sdkCtx.WithBlockHeight(5)))
+	require.Equal(t, math.NewInt(1_000), balanceOf(feeCollector))
+
+	require.NoError(t, input.OPChildKeeper.DistributeFees(sdkCtx.WithBlockHeight(10)))
+	require.Equal(t, math.NewInt(100), balanceOf(feeCollector))
+	require.Equal(t, beforeBalances[0].AddRaw(250), balanceOf(testutil.Addrs[0]))
+	require.Equal(t, beforeBalances[1].AddRaw(250), balanceOf(testutil.Addrs[1]))
+	require.Equal(t, beforeBalances[2].AddRaw(200), balanceOf(testutil.Addrs[2]))
+	require.Equal(t, beforeBalances[3].AddRaw(100), balanceOf(testutil.Addrs[3]))
+	require.Equal(t, beforeSupply.SubRaw(100), input.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount)
+
+	// the registered sequencer set replaces the legacy single sequencer, and the totals are accumulated
+	require.NoError(t, input.OPChildKeeper.Sequencers.Set(ctx, testutil.ValAddrs[0], types.Sequencer{
+		Moniker:         "sequencer0",
+		OperatorAddress: testutil.ValAddrsStr[0],
+		Weight:          types.AttestorConsPower,
+	}))
+
+	input.Faucet.Fund(ctx, feeCollector, sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(900)))
+	require.NoError(t, input.OPChildKeeper.DistributeFees(sdkCtx.WithBlockHeight(20)))
+	require.Equal(t, beforeBalances[1].AddRaw(250), balanceOf(testutil.Addrs[1]))
+	require.Equal(t, beforeBalances[2].AddRaw(400), balanceOf(testutil.Addrs[2]))
+
+	res, err := q.FeeDistributionTotal(ctx, &types.QueryFeeDistributionTotalRequest{Recipient: testutil.AddrsStr[0]})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(750))), res.Total.Amount)
+
+	res, err = q.FeeDistributionTotal(ctx, &types.QueryFeeDistributionTotalRequest{Recipient: types.FeeRecipientBurn})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(200))), res.Total.Amount)
+
+	resAll, err := q.FeeDistributionTotals(ctx, &types.QueryFeeDistributionTotalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resAll.Totals, 5)
+}
+
+func Test_DistributeMEVRewards(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	escrow := keeper.MEVRewardsEscrowAddress()
+
+	// the proceeds go to the fee collector by default
+	addr, err := input.OPChildKeeper.MEVRewardsAddress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, feeCollector, addr)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// the address is required with the ratio
+	params.MevRewardsRatio = math.LegacyNewDecWithPrec(3, 1)
+	require.ErrorIs(t, input.OPChildKeeper.SetParams(ctx, params), types.ErrInvalidMEVRewards)
+
+	params.MevRewardsAddress = testutil.AddrsStr[4]
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	addr, err = input.OPChildKeeper.MEVRewardsAddress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, escrow, addr)
+
+	// auction proceeds
+	input.Faucet.Fund(ctx, escrow, sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1_000)))
+
+	beforeRecipient := input.BankKeeper.GetBalance(ctx, testutil.Addrs[4], sdk.DefaultBondDenom).Amount
+	beforeFeeCollector := input.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).Amount
+
+	require.NoError(t, input.OPChildKeeper.DistributeMEVRewards(ctx))
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, escrow).IsZero())
+	require.Equal(t, beforeRecipient.AddRaw(300), input.BankKeeper.GetBalance(ctx, testutil.Addrs[4], sdk.DefaultBondDenom).Amount)
+	require.Equal(t, beforeFeeCollector.AddRaw(700), input.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).Amount)
+
+	total, err := input.OPChildKeeper.GetFeeDistributionTotal(ctx, testutil.AddrsStr[4])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(300))), total.Amount)
+}
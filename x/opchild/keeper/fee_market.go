@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	gomath "math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// GetBaseFeeMultiplier returns the multiplier of params.MinGasPrices for the base fee.
+func (k Keeper) GetBaseFeeMultiplier(ctx context.Context) (math.LegacyDec, error) {
+	multiplier, err := k.BaseFeeMultiplier.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyOneDec(), nil
+	}
+
+	return multiplier, err
+}
+
+// BaseGasPrices returns params.MinGasPrices multiplied by the base fee multiplier, with the gas
+// prices of the oracle fee denoms converted from the gas price of params.FeeBaseDenom. When the fee
+// market is disabled, the multiplier is not applied.
+func (k Keeper) BaseGasPrices(ctx context.Context) (sdk.DecCoins, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrices := params.MinGasPrices
+	if params.TargetBlockGas != 0 {
+		multiplier, err := k.GetBaseFeeMultiplier(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		gasPrices = gasPrices.MulDec(multiplier)
+	}
+
+	return k.convertOracleGasPrices(ctx, params, gasPrices), nil
+}
+
+// convertOracleGasPrices converts the gas price of params.FeeBaseDenom into the gas prices of the
+// oracle fee denoms. The fee denom falls back to its static gas price in params.MinGasPrices when
+// the oracle price is missing or stale.
+func (k Keeper) convertOracleGasPrices(ctx context.Context, params types.Params, gasPrices sdk.DecCoins) sdk.DecCoins {
+	if len(params.FeeDenomOracles) == 0 || k.l2OracleHandler == nil {
+		return gasPrices
+	}
+
+	basePrice := gasPrices.AmountOf(params.FeeBaseDenom)
+	if !basePrice.IsPositive() {
+		return gasPrices
+	}
+
+	for _, oracle := range params.FeeDenomOracles {
+		price, ok := k.getFeeDenomPrice(ctx, oracle, params.MaxOraclePriceAge)
+		if !ok {
+			continue
+		}
+
+		// replace the static gas price with the converted one
+		converted := make(sdk.DecCoins, 0, len(gasPrices))
+		for _, gasPrice := range gasPrices {
+			if gasPrice.Denom != oracle.Denom {
+				converted = append(converted, gasPrice)
+			}
+		}
+
+		gasPrices = converted.Add(sdk.NewDecCoinFromDec(oracle.Denom, basePrice.Quo(price)))
+	}
+
+	return gasPrices
+}
+
+// getFeeDenomPrice returns the value of one unit of the fee denom in the fee base denom from the
+// oracle. It returns false if the price is missing or older than maxAge seconds.
+func (k Keeper) getFeeDenomPrice(ctx context.Context, oracle types.FeeDenomOracle, maxAge uint64) (math.LegacyDec, bool) {
+	cp, err := connecttypes.CurrencyPairFromString(oracle.CurrencyPair)
+	if err != nil {
+		return math.LegacyDec{}, false
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	quotePrice, err := k.l2OracleHandler.oracleKeeper.GetPriceForCurrencyPair(sdkCtx, cp)
+	if err != nil || quotePrice.Price.IsNil() || !quotePrice.Price.IsPositive() {
+		return math.LegacyDec{}, false
+	}
+
+	if maxAge != 0 && sdkCtx.BlockTime().Sub(quotePrice.BlockTimestamp) > time.Duration(maxAge)*time.Second {
+		return math.LegacyDec{}, false
+	}
+
+	price := math.LegacyNewDecFromInt(quotePrice.Price).Quo(math.LegacyNewDec(10).Power(uint64(oracle.Decimals)))
+	if !price.IsPositive() {
+		return math.LegacyDec{}, false
+	}
+
+	return price, true
+}
+
+// AddBlockGasUsed adds the gas used by a tx to the gas used in the current block, which is read
+// and reset by UpdateBaseFee at the end block. It is a no-op when the fee market is disabled.
+func (k Keeper) AddBlockGasUsed(ctx context.Context, gas uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	} else if params.TargetBlockGas == 0 {
+		return nil
+	}
+
+	gasUsed, err := k.BlockGasUsed.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// saturate instead of overflowing; the delta is bounded anyway
+	if gasUsed+gas < gasUsed {
+		gasUsed = gomath.MaxUint64
+	} else {
+		gasUsed += gas
+	}
+
+	return k.BlockGasUsed.Set(ctx, gasUsed)
+}
+
+// UpdateBaseFee adjusts the base fee multiplier by the gas used in the current block, in the way
+// of EIP-1559. The multiplier is raised when the block uses more gas than the target and lowered
+// when it uses less, by at most 1/params.BaseFeeChangeDenominator per block, and never below one
+// or above params.MaxBaseFeeMultiplier.
+func (k Keeper) UpdateBaseFee(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the gas used is accumulated by AddBlockGasUsed during the block, and reset for the next block
+	gasUsed, err := k.BlockGasUsed.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	} else if err := k.BlockGasUsed.Remove(ctx); err != nil {
+		return err
+	}
+
+	// reset the multiplier, so the fee market starts from the min gas prices when enabled again
+	if params.TargetBlockGas == 0 {
+		return k.BaseFeeMultiplier.Remove(ctx)
+	}
+
+	multiplier, err := k.GetBaseFeeMultiplier(ctx)
+	if err != nil {
+		return err
+	}
+
+	// delta = (gas_used - target) / target / denominator, bounded by 1/denominator
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockGas))
+	delta := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed)).Sub(target).Quo(target)
+	if delta.GT(math.LegacyOneDec()) {
+		delta = math.LegacyOneDec()
+	}
+	delta = delta.QuoInt64(int64(params.BaseFeeChangeDenominator))
+
+	multiplier = multiplier.Add(multiplier.Mul(delta))
+	if multiplier.LT(math.LegacyOneDec()) {
+		multiplier = math.LegacyOneDec()
+	} else if maxMultiplier := params.MaxBaseFeeMultiplier; !maxMultiplier.IsNil() && maxMultiplier.IsPositive() && multiplier.GT(maxMultiplier) {
+		multiplier = maxMultiplier
+	}
+
+	if err := k.BaseFeeMultiplier.Set(ctx, multiplier); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateBaseFee,
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		sdk.NewAttribute(types.AttributeKeyBaseFee, multiplier.String()),
+	))
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+func Test_UpdateBaseFee(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("test1", math.LegacyNewDecWithPrec(16, 2)))
+
+	// the fee market is disabled by default, and the gas used is not accumulated
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+	require.NoError(t, input.OPChildKeeper.AddBlockGasUsed(ctx, 1_000_000))
+	_, err = input.OPChildKeeper.BlockGasUsed.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	require.NoError(t, input.OPChildKeeper.UpdateBaseFee(ctx))
+	gasPrices, err := input.OPChildKeeper.MinGasPrices(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.MinGasPrices, gasPrices)
+
+	// the denominator cannot be zero when enabled
+	params.TargetBlockGas = 1_000_000
+	params.BaseFeeChangeDenominator = 0
+	require.ErrorIs(t, input.OPChildKeeper.SetParams(ctx, params), types.ErrInvalidFeeMarket)
+
+	params.BaseFeeChangeDenominator = 8
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	// the base fee is raised by 1/8 at most
+	require.NoError(t, input.OPChildKeeper.AddBlockGasUsed(ctx, 1_000_000))
+	require.NoError(t, input.OPChildKeeper.AddBlockGasUsed(ctx, 2_000_000))
+	require.NoError(t, input.OPChildKeeper.UpdateBaseFee(ctx))
+
+	// the block gas used is reset for the next block
+	_, err = input.OPChildKeeper.BlockGasUsed.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	multiplier, err := input.OPChildKeeper.GetBaseFeeMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1125, 3), multiplier)
+
+	gasPrices, err = input.OPChildKeeper.MinGasPrices(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(18, 2), gasPrices.AmountOf("test1"))
+
+	// half of the target lowers the base fee by 1/16
+	require.NoError(t, input.OPChildKeeper.AddBlockGasUsed(ctx, 500_000))
+	require.NoError(t, input.OPChildKeeper.UpdateBaseFee(ctx))
+
+	multiplier, err = input.OPChildKeeper.GetBaseFeeMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1125, 3).Mul(math.LegacyNewDecWithPrec(9375, 4)), multiplier)
+
+	// the base fee never goes below the min gas prices
+	for i := 0; i < 10; i++ {
+		require.NoError(t, input.OPChildKeeper.UpdateBaseFee(ctx))
+	}
+
+	multiplier, err = input.OPChildKeeper.GetBaseFeeMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyOneDec(), multiplier)
+
+	// the max base fee multiplier cannot be less than one
+	params.MaxBaseFeeMultiplier = math.LegacyNewDecWithPrec(5, 1)
+	require.ErrorIs(t, input.OPChildKeeper.SetParams(ctx, params), types.ErrInvalidFeeMarket)
+
+	// the base fee never goes above the max base fee multiplier
+	params.MaxBaseFeeMultiplier = math.LegacyNewDecWithPrec(12, 1)
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+	for i := 0; i < 10; i++ {
+		require.NoError(t, input.OPChildKeeper.AddBlockGasUsed(ctx, 3_000_000))
+		require.NoError(t, input.OPChildKeeper.UpdateBaseFee(ctx))
+	}
+
+	multiplier, err = input.OPChildKeeper.GetBaseFeeMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(12, 1), multiplier)
+
+	// query the gas price
+	require.NoError(t, input.OPChildKeeper.BaseFeeMultiplier.Set(ctx, math.LegacyNewDec(2)))
+	res, err := keeper.NewQuerier(&input.OPChildKeeper).GasPrice(ctx, &types.QueryGasPriceRequest{Denom: "test1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("test1", math.LegacyNewDecWithPrec(32, 2))), res.GasPrices)
+	require.Equal(t, math.LegacyNewDec(2), res.BaseFeeMultiplier)
+
+	_, err = keeper.NewQuerier(&input.OPChildKeeper).GasPrice(ctx, &types.QueryGasPriceRequest{Denom: "test2"})
+	require.Error(t, err)
+
+	// disabling the fee market resets the base fee
+	params.TargetBlockGas = 0
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+	require.NoError(t, input.OPChildKeeper.UpdateBaseFee(ctx))
+
+	multiplier, err = input.OPChildKeeper.GetBaseFeeMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyOneDec(), multiplier)
+}
+
+func Test_OracleGasPrices(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Unix(10_000, 0).UTC())
+
+	input.OracleKeeper.InitGenesis(sdkCtx, oracletypes.GenesisState{
+		CurrencyPairGenesis: make([]oracletypes.CurrencyPairGenesis, 0),
+	})
+
+	params, err := input.OPChildKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MinGasPrices = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("test1", math.LegacyNewDecWithPrec(2, 1)),
+		sdk.NewDecCoinFromDec("test2", math.LegacyNewDecWithPrec(5, 2)),
+	)
+	params.FeeBaseDenom = "test1"
+	params.FeeDenomOracles = []types.FeeDenomOracle{
+		{Denom: "test2", CurrencyPair: "TEST2/TEST1", Decimals: 2},
+		{Denom: "test3", CurrencyPair: "TEST3/TEST1", Decimals: 0},
+	}
+	params.MaxOraclePriceAge = 600
+
+	// the fee base denom cannot be priced by the oracle
+	params.FeeDenomOracles = append(params.FeeDenomOracles, types.FeeDenomOracle{Denom: "test1", CurrencyPair: "TEST1/TEST2"})
+	require.ErrorIs(t, input.OPChildKeeper.SetParams(sdkCtx, params), types.ErrInvalidFeeMarket)
+	params.FeeDenomOracles = params.FeeDenomOracles[:2]
+
+	// the decimals are bounded
+	params.FeeDenomOracles[1].Decimals = 1_000_000
+	require.ErrorIs(t, input.OPChildKeeper.SetParams(sdkCtx, params), types.ErrInvalidFeeMarket)
+	params.FeeDenomOracles[1].Decimals = 0
+	require.NoError(t, input.OPChildKeeper.SetParams(sdkCtx, params))
+
+	// without the oracle prices, the static gas prices are used
+	gasPrices, err := input.OPChildKeeper.MinGasPrices(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, params.MinGasPrices, gasPrices)
+
+	// set the oracle prices; 1 test2 = 2 test1, 1 test3 = 4 test1
+	for cpStr, price := range map[string]int64{"TEST2/TEST1": 200, "TEST3/TEST1": 4} {
+		cp, err := connecttypes.CurrencyPairFromString(cpStr)
+		require.NoError(t, err)
+		require.NoError(t, input.OracleKeeper.CreateCurrencyPair(sdkCtx, cp))
+		require.NoError(t, input.OracleKeeper.SetPriceForCurrencyPair(sdkCtx, cp, oracletypes.QuotePrice{
+			Price:          math.NewInt(price),
+			BlockTimestamp: sdkCtx.BlockTime(),
+			BlockHeight:    1,
+		}))
+	}
+
+	gasPrices, err = input.OPChildKeeper.MinGasPrices(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 1), gasPrices.AmountOf("test1"))
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), gasPrices.AmountOf("test2"))
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), gasPrices.AmountOf("test3"))
+
+	// the converted gas prices follow the base fee
+	params.TargetBlockGas = 1_000_000
+	require.NoError(t, input.OPChildKeeper.SetParams(sdkCtx, params))
+	require.NoError(t, input.OPChildKeeper.BaseFeeMultiplier.Set(sdkCtx, math.LegacyNewDec(2)))
+
+	gasPrices, err = input.OPChildKeeper.MinGasPrices(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(4, 1), gasPrices.AmountOf("test1"))
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 1), gasPrices.AmountOf("test2"))
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), gasPrices.AmountOf("test3"))
+
+	// the stale oracle prices fall back to the static gas prices
+	staleCtx := sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(601 * time.Second))
+	gasPrices, err = input.OPChildKeeper.MinGasPrices(staleCtx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(4, 1), gasPrices.AmountOf("test1"))
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), gasPrices.AmountOf("test2"))
+	require.True(t, gasPrices.AmountOf("test3").IsZero())
+}
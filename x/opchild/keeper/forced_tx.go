@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func (k Keeper) GetNextForcedTxSequence(ctx context.Context) (uint64, error) {
+	nextSequence, err := k.NextForcedTxSequence.Peek(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if nextSequence == collections.DefaultSequenceStart {
+		return ophosttypes.DefaultForcedTxSequenceStart, nil
+	}
+
+	return nextSequence, nil
+}
+
+func (k Keeper) SetNextForcedTxSequence(ctx context.Context, sequence uint64) error {
+	return k.NextForcedTxSequence.Set(ctx, sequence)
+}
+
+// OnRecvForcedTxPacket handles the forced tx packet received over the opinit channel
+// and returns the marshaled acknowledgement.
+func (k Keeper) OnRecvForcedTxPacket(
+	ctx context.Context,
+	data ophosttypes.ForcedTxPacketData,
+) ([]byte, error) {
+	ack, err := k.HandleForcedTxPacket(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	ackBytes, err := json.Marshal(ack)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, "failed to marshal acknowledgement")
+	}
+
+	return ackBytes, nil
+}
+
+// HandleForcedTxPacket queues the forced tx submitted on L1. The tx is executed in the order of
+// the sequence from the end of the next block, and the acknowledgement carries the L2 height at
+// which the tx is queued and the next forced tx sequence, which lets L1 prune the processed
+// forced txs.
+func (k Keeper) HandleForcedTxPacket(
+	ctx context.Context,
+	data ophosttypes.ForcedTxPacketData,
+) (ophosttypes.ForcedTxPacketAck, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight()) //nolint:gosec
+
+	bridgeInfo, err := k.BridgeInfo.Get(ctx)
+	if err != nil {
+		return ophosttypes.ForcedTxPacketAck{
+			Success: false,
+			Error:   fmt.Sprintf("failed to get bridge info: %v", err),
+		}, nil
+	}
+
+	if data.BridgeId != bridgeInfo.BridgeId {
+		return ophosttypes.ForcedTxPacketAck{
+			Success: false,
+			Error:   fmt.Sprintf("bridge ID mismatch: expected %d, got %d", bridgeInfo.BridgeId, data.BridgeId),
+		}, nil
+	}
+
+	nextSequence, err := k.GetNextForcedTxSequence(ctx)
+	if err != nil {
+		return ophosttypes.ForcedTxPacketAck{}, err
+	} else if data.Sequence < nextSequence {
+		return ophosttypes.ForcedTxPacketAck{
+			Success:              false,
+			Error:                fmt.Sprintf("forced tx %d is already processed", data.Sequence),
+			NextForcedTxSequence: nextSequence,
+		}, nil
+	}
+
+	// a forced tx sent again after the timeout keeps the original reception height
+	if forcedTx, err := k.ForcedTxs.Get(ctx, data.Sequence); err == nil {
+		return ophosttypes.ForcedTxPacketAck{
+			Success:              true,
+			L2BlockHeight:        forcedTx.L2ReceivedHeight,
+			NextForcedTxSequence: nextSequence,
+		}, nil
+	}
+
+	if err := k.ForcedTxs.Set(ctx, data.Sequence, ophosttypes.ForcedTx{
+		BridgeId:         data.BridgeId,
+		Sequence:         data.Sequence,
+		Sender:           data.Sender,
+		Tx:               data.Tx,
+		L1BlockHeight:    data.L1BlockHeight,
+		L2ReceivedHeight: height,
+	}); err != nil {
+		return ophosttypes.ForcedTxPacketAck{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReceiveForcedTx,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(data.BridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyForcedTxSeq, strconv.FormatUint(data.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyL2BlockHeight, strconv.FormatUint(height, 10)),
+	))
+
+	return ophosttypes.ForcedTxPacketAck{
+		Success:              true,
+		L2BlockHeight:        height,
+		NextForcedTxSequence: nextSequence,
+	}, nil
+}
+
+// ProcessForcedTxs executes the forced txs received before the current block in the order of
+// the sequence, starting from the next forced tx sequence. The execution stops at a missing
+// sequence, so a forced tx is never executed before the ones submitted earlier on L1, and at
+// params.MaxForcedTxsPerBlock. A failed tx is also processed, so the forced tx cannot block the
+// queue. This should be called in EndBlocker before the L2 status report, which carries the next
+// forced tx sequence to L1.
+func (k Keeper) ProcessForcedTxs(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight()) //nolint:gosec
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	nextSequence, err := k.GetNextForcedTxSequence(ctx)
+	if err != nil {
+		return err
+	}
+
+	processed := uint64(0)
+	for params.MaxForcedTxsPerBlock == 0 || processed < params.MaxForcedTxsPerBlock {
+		forcedTx, err := k.ForcedTxs.Get(ctx, nextSequence)
+		if errors.Is(err, collections.ErrNotFound) {
+			break
+		} else if err != nil {
+			return err
+		} else if forcedTx.L2ReceivedHeight >= height {
+			break
+		}
+
+		success, reason := k.runWithHookGas(sdkCtx, params.HookMaxGas, "forced tx", func(ctx sdk.Context) (bool, string) {
+			return k.executeSignedTx(ctx, forcedTx.Tx)
+		})
+
+		if err := k.ForcedTxs.Remove(ctx, forcedTx.Sequence); err != nil {
+			return err
+		}
+
+		nextSequence++
+		processed++
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExecuteForcedTx,
+			sdk.NewAttribute(types.AttributeKeyForcedTxSeq, strconv.FormatUint(forcedTx.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, forcedTx.Sender),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(success)),
+			sdk.NewAttribute(types.AttributeKeyReason, truncateReason(reason, maxEventReasonLength)),
+		))
+	}
+
+	if processed == 0 {
+		return nil
+	}
+
+	return k.SetNextForcedTxSequence(ctx, nextSequence)
+}
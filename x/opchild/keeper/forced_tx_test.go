@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_ForcedTxs(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	require.NoError(t, input.OPChildKeeper.BridgeInfo.Set(ctx, types.BridgeInfo{
+		BridgeId:  1,
+		L1ChainId: "test-chain-1",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			ForcedInclusionPeriod: 10,
+		},
+	}))
+
+	bz := sha3.Sum256([]byte("test_token"))
+	denom := "l2/" + hex.EncodeToString(bz[:])
+
+	// fund the forced tx sender
+	priv, _, addr := testutil.KeyPubAddr()
+	_, err := ms.FinalizeTokenDeposit(ctx, types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], addr.String(), sdk.NewCoin(denom, math.NewInt(100)), 1, 1, "test_token", nil))
+	require.NoError(t, err)
+
+	acc := input.AccountKeeper.GetAccount(ctx, addr)
+	require.NotNil(t, acc)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}
+	signedTxBz, err := input.EncodingConfig.TxConfig.TxEncoder()(testutil.GenerateTestTx(
+		t, input,
+		[]sdk.Msg{banktypes.NewMsgSend(addr, testutil.Addrs[2], sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(50))))},
+		privs, accNums, accSeqs, ctx.ChainID(),
+	))
+	require.NoError(t, err)
+
+	forcedTx := ophosttypes.ForcedTxPacketData{
+		BridgeId:      1,
+		Sequence:      1,
+		Sender:        testutil.AddrsStr[3],
+		Tx:            signedTxBz,
+		L1BlockHeight: 5,
+	}
+
+	// bridge id mismatch
+	ctx = ctx.WithBlockHeight(10)
+	ack, err := input.OPChildKeeper.HandleForcedTxPacket(ctx, ophosttypes.ForcedTxPacketData{BridgeId: 2, Sequence: 1})
+	require.NoError(t, err)
+	require.False(t, ack.Success)
+
+	ack, err = input.OPChildKeeper.HandleForcedTxPacket(ctx, forcedTx)
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+	require.Equal(t, uint64(10), ack.L2BlockHeight)
+
+	// an invalid tx is also queued
+	ack, err = input.OPChildKeeper.HandleForcedTxPacket(ctx, ophosttypes.ForcedTxPacketData{BridgeId: 1, Sequence: 2, Tx: []byte{1, 2, 3}})
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+
+	// the forced tx sent again keeps the reception height
+	ack, err = input.OPChildKeeper.HandleForcedTxPacket(ctx.WithBlockHeight(11), forcedTx)
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+	require.Equal(t, uint64(10), ack.L2BlockHeight)
+
+	// the forced txs are executed from the next block
+	require.NoError(t, input.OPChildKeeper.ProcessForcedTxs(ctx))
+	res, err := keeper.NewQuerier(&input.OPChildKeeper).ForcedTxs(ctx, &types.QueryForcedTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ForcedTxs, 2)
+	require.Equal(t, uint64(1), res.NextForcedTxSequence)
+
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, input.OPChildKeeper.ProcessForcedTxs(ctx))
+	require.Equal(t, math.NewInt(50), input.BankKeeper.GetBalance(ctx, testutil.Addrs[2], denom).Amount)
+
+	res, err = keeper.NewQuerier(&input.OPChildKeeper).ForcedTxs(ctx, &types.QueryForcedTxsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.ForcedTxs)
+	require.Equal(t, uint64(3), res.NextForcedTxSequence)
+
+	// the processed forced tx is rejected with the next forced tx sequence
+	ack, err = input.OPChildKeeper.HandleForcedTxPacket(ctx, forcedTx)
+	require.NoError(t, err)
+	require.False(t, ack.Success)
+	require.Equal(t, uint64(3), ack.NextForcedTxSequence)
+
+	// the next forced tx sequence is reported to L1
+	report, err := input.OPChildKeeper.BuildL2StatusReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), report.NextForcedTxSequence)
+
+	// the forced tx received out of order waits for the earlier one
+	ack, err = input.OPChildKeeper.HandleForcedTxPacket(ctx, ophosttypes.ForcedTxPacketData{BridgeId: 1, Sequence: 4, Tx: []byte{1, 2, 3}})
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, input.OPChildKeeper.ProcessForcedTxs(ctx))
+	res, err = keeper.NewQuerier(&input.OPChildKeeper).ForcedTxs(ctx, &types.QueryForcedTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ForcedTxs, 1)
+	require.Equal(t, uint64(3), res.NextForcedTxSequence)
+
+	ack, err = input.OPChildKeeper.HandleForcedTxPacket(ctx, ophosttypes.ForcedTxPacketData{BridgeId: 1, Sequence: 3, Tx: []byte{1, 2, 3}})
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+
+	// the forced txs are executed up to the max forced txs per block
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxForcedTxsPerBlock = 1
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(13)
+	require.NoError(t, input.OPChildKeeper.ProcessForcedTxs(ctx))
+	res, err = keeper.NewQuerier(&input.OPChildKeeper).ForcedTxs(ctx, &types.QueryForcedTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ForcedTxs, 1)
+	require.Equal(t, uint64(4), res.NextForcedTxSequence)
+
+	ctx = ctx.WithBlockHeight(14)
+	require.NoError(t, input.OPChildKeeper.ProcessForcedTxs(ctx))
+	res, err = keeper.NewQuerier(&input.OPChildKeeper).ForcedTxs(ctx, &types.QueryForcedTxsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.ForcedTxs)
+	require.Equal(t, uint64(5), res.NextForcedTxSequence)
+}
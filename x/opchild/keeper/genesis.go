@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 // InitGenesis sets the pool and parameters for the provided keeper.  For each
@@ -41,6 +44,17 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	for _, sequencer := range data.Sequencers {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(sequencer.OperatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.Sequencers.Set(ctx, valAddr, sequencer); err != nil {
+			panic(err)
+		}
+	}
+
 	// don't need to run Tendermint updates if we exported
 	if data.Exported {
 		for _, lv := range data.LastValidatorPowers {
@@ -64,8 +78,12 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 			res = append(res, update)
 		}
 	} else {
-		var err error
+		// include the active sequencers in the initial validator set
+		if err := k.RotateSequencers(ctx); err != nil {
+			panic(err)
+		}
 
+		var err error
 		res, err = k.ApplyAndReturnValidatorSetUpdates(ctx)
 		if err != nil {
 			panic(err)
@@ -115,6 +133,84 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	for _, plan := range data.ExecutorChangePlans {
+		if err := k.SetExecutorChangePlan(ctx, plan); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, pendingDeposit := range data.PendingDeposits {
+		if err := k.PendingDeposits.Set(ctx, pendingDeposit.Sequence, pendingDeposit); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.NextForcedTxSequence != 0 {
+		if err := k.SetNextForcedTxSequence(ctx, data.NextForcedTxSequence); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, forcedTx := range data.ForcedTxs {
+		if err := k.ForcedTxs.Set(ctx, forcedTx.Sequence, forcedTx); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, node := range data.WithdrawalTreeNodes {
+		if err := k.WithdrawalTree.Set(ctx, collections.Join(node.Level, node.Index), node.Hash); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.WithdrawalRecords {
+		if err := k.SetWithdrawalRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, blockHash := range data.BlockHashes {
+		if err := k.BlockHashes.Set(ctx, blockHash.Height, blockHash.Hash); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.DepositRecords {
+		if err := k.SetDepositRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, migrationStatus := range data.MigrationStatuses {
+		if err := k.MigrationStatuses.Set(ctx, migrationStatus.Denom, migrationStatus); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, autoMigration := range data.AutoMigrations {
+		if err := k.AutoMigrations.Set(ctx, autoMigration.Denom, autoMigration); err != nil {
+			panic(err)
+		}
+	}
+
+	if !data.BaseFeeMultiplier.IsNil() {
+		if err := k.BaseFeeMultiplier.Set(ctx, data.BaseFeeMultiplier); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, sponsorship := range data.Sponsorships {
+		if err := k.Sponsorships.Set(ctx, collections.Join(sponsorship.Account, sponsorship.MsgTypeUrl), sponsorship); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, total := range data.FeeDistributionTotals {
+		if err := k.FeeDistributions.Set(ctx, total.Recipient, total); err != nil {
+			panic(err)
+		}
+	}
+
 	return res
 }
 
@@ -181,15 +277,139 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	pendingDeposits, err := k.GetPendingDeposits(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	executorChangePlans, err := k.GetExecutorChangePlans(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	sequencers, err := k.GetSequencers(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var forcedTxs []ophosttypes.ForcedTx
+	err = k.ForcedTxs.Walk(ctx, nil, func(_ uint64, forcedTx ophosttypes.ForcedTx) (stop bool, err error) {
+		forcedTxs = append(forcedTxs, forcedTx)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	nextForcedTxSequence, err := k.GetNextForcedTxSequence(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var withdrawalTreeNodes []types.WithdrawalTreeNode
+	err = k.WithdrawalTree.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], hash []byte) (stop bool, err error) {
+		withdrawalTreeNodes = append(withdrawalTreeNodes, types.WithdrawalTreeNode{
+			Level: key.K1(),
+			Index: key.K2(),
+			Hash:  hash,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var withdrawalRecords []types.WithdrawalRecord
+	err = k.WithdrawalRecords.Walk(ctx, nil, func(_ uint64, record types.WithdrawalRecord) (stop bool, err error) {
+		withdrawalRecords = append(withdrawalRecords, record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var blockHashes []types.BlockHash
+	err = k.BlockHashes.Walk(ctx, nil, func(height uint64, hash []byte) (stop bool, err error) {
+		blockHashes = append(blockHashes, types.BlockHash{Height: height, Hash: hash})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var depositRecords []types.DepositRecord
+	err = k.DepositRecords.Walk(ctx, nil, func(_ uint64, record types.DepositRecord) (stop bool, err error) {
+		depositRecords = append(depositRecords, record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var migrationStatuses []types.MigrationStatus
+	err = k.MigrationStatuses.Walk(ctx, nil, func(_ string, migrationStatus types.MigrationStatus) (stop bool, err error) {
+		migrationStatuses = append(migrationStatuses, migrationStatus)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var autoMigrations []types.AutoMigration
+	err = k.AutoMigrations.Walk(ctx, nil, func(_ string, autoMigration types.AutoMigration) (stop bool, err error) {
+		autoMigrations = append(autoMigrations, autoMigration)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	baseFeeMultiplier, err := k.GetBaseFeeMultiplier(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var sponsorships []types.Sponsorship
+	err = k.Sponsorships.Walk(ctx, nil, func(_ collections.Pair[string, string], sponsorship types.Sponsorship) (stop bool, err error) {
+		sponsorships = append(sponsorships, sponsorship)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var feeDistributionTotals []types.FeeDistributionTotal
+	err = k.FeeDistributions.Walk(ctx, nil, func(_ string, total types.FeeDistributionTotal) (stop bool, err error) {
+		feeDistributionTotals = append(feeDistributionTotals, total)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:              params,
-		LastValidatorPowers: lastValidatorPowers,
-		Validators:          validators,
-		Exported:            true,
-		NextL1Sequence:      finalizedL1Sequence,
-		NextL2Sequence:      nextL2Sequence,
-		BridgeInfo:          bridgeInfo,
-		DenomPairs:          denomPairs,
-		MigrationInfos:      migrationInfos,
+		Params:                params,
+		LastValidatorPowers:   lastValidatorPowers,
+		Validators:            validators,
+		Exported:              true,
+		NextL1Sequence:        finalizedL1Sequence,
+		NextL2Sequence:        nextL2Sequence,
+		BridgeInfo:            bridgeInfo,
+		DenomPairs:            denomPairs,
+		MigrationInfos:        migrationInfos,
+		PendingDeposits:       pendingDeposits,
+		ExecutorChangePlans:   executorChangePlans,
+		Sequencers:            sequencers,
+		ForcedTxs:             forcedTxs,
+		NextForcedTxSequence:  nextForcedTxSequence,
+		WithdrawalTreeNodes:   withdrawalTreeNodes,
+		WithdrawalRecords:     withdrawalRecords,
+		BlockHashes:           blockHashes,
+		DepositRecords:        depositRecords,
+		MigrationStatuses:     migrationStatuses,
+		AutoMigrations:        autoMigrations,
+		BaseFeeMultiplier:     baseFeeMultiplier,
+		Sponsorships:          sponsorships,
+		FeeDistributionTotals: feeDistributionTotals,
 	}
 }
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/initia-labs/OPinit/x/opchild/testutil"
 	"github.com/initia-labs/OPinit/x/opchild/types"
 	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
@@ -88,6 +90,60 @@ func Test_GenesisImportExport(t *testing.T) {
 		},
 	}
 
+	genState.PendingDeposits = []types.PendingDeposit{
+		{
+			Sender:         testutil.AddrsStr[0],
+			From:           testutil.AddrsStr[1],
+			To:             testutil.AddrsStr[2],
+			Amount:         sdk.NewInt64Coin(l2DenomFoo, 100),
+			Sequence:       5,
+			Height:         10,
+			BaseDenom:      "foo",
+			ReceivedHeight: 1,
+		},
+	}
+
+	genState.ForcedTxs = []ophosttypes.ForcedTx{
+		{
+			BridgeId:         1,
+			Sequence:         3,
+			Sender:           testutil.AddrsStr[0],
+			Tx:               []byte{1, 2, 3},
+			L1BlockHeight:    10,
+			L2ReceivedHeight: 20,
+		},
+	}
+	genState.NextForcedTxSequence = 3
+	genState.WithdrawalTreeNodes = []types.WithdrawalTreeNode{
+		{Level: 0, Index: 0, Hash: make([]byte, 32)},
+		{Level: 0, Index: 1, Hash: make([]byte, 32)},
+		{Level: 1, Index: 0, Hash: make([]byte, 32)},
+	}
+	genState.WithdrawalRecords = []types.WithdrawalRecord{
+		{
+			Sequence:  1,
+			Sender:    testutil.AddrsStr[0],
+			Receiver:  testutil.AddrsStr[1],
+			Amount:    sdk.NewInt64Coin(l2DenomFoo, 100),
+			BaseDenom: "foo",
+			Height:    10,
+		},
+	}
+	genState.BlockHashes = []types.BlockHash{{Height: 10, Hash: make([]byte, 32)}}
+	genState.DepositRecords = []types.DepositRecord{
+		{
+			Sequence:         1,
+			From:             testutil.AddrsStr[0],
+			To:               testutil.AddrsStr[1],
+			Amount:           sdk.NewInt64Coin(l2DenomFoo, 100),
+			BaseDenom:        "foo",
+			Success:          false,
+			Reason:           "failed to send coins",
+			RefundL2Sequence: 1,
+			Height:           10,
+		},
+	}
+
 	input.OPChildKeeper.InitGenesis(ctx, genState)
 	genState_ := input.OPChildKeeper.ExportGenesis(ctx)
 	require.Equal(t, genState, genState_)
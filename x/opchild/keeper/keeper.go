@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/core/address"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -18,6 +19,7 @@ import (
 	cosmostypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 var _ types.AnteKeeper = Keeper{}
@@ -60,7 +62,33 @@ type Keeper struct {
 	MigrationInfos       collections.Map[string, types.MigrationInfo] // l2 denom -> migration info
 	IBCToL2DenomMap      collections.Map[string, string]              // ibc denom -> l2 denom
 	ShutdownInfo         collections.Item[types.ShutdownInfo]
-
+	MigrationStatuses    collections.Map[string, types.MigrationStatus]            // l2 denom -> migration status
+	AutoMigrations       collections.Map[string, types.AutoMigration]              // l2 denom -> auto migration progress
+	OPinitChannelId      collections.Item[string]                                  // L2 side channel id of the opinit channel
+	LastStatusReport     collections.Item[uint64]                                  // L2 height of the last status report
+	PendingDeposits      collections.Map[uint64, types.PendingDeposit]             // l1 sequence -> pending deposit
+	DepositVotes         collections.Map[collections.Pair[uint64, []byte], []byte] // (l1 sequence, bridge executor) -> payload hash
+	BridgeInfoVotes      collections.Map[[]byte, []byte]                           // bridge executor -> payload hash
+	ExecutorChanges      collections.Map[uint64, types.ExecutorChangePlan]         // height -> executor change plan
+	Sequencers           collections.Map[[]byte, types.Sequencer]                  // operator -> sequencer
+	ForcedTxs            collections.Map[uint64, ophosttypes.ForcedTx]             // forced tx sequence -> forced tx
+	NextForcedTxSequence collections.Sequence
+	WithdrawalTree       collections.Map[collections.Pair[uint64, uint64], []byte] // (level, index) -> withdrawal merkle tree node
+	WithdrawalRecords    collections.Map[uint64, types.WithdrawalRecord]           // l2 sequence -> withdrawal record
+	BlockHashes          collections.Map[uint64, []byte]                           // l2 block height -> block hash
+	SenderWithdrawals    collections.KeySet[collections.Pair[string, uint64]]      // (sender, l2 sequence)
+	ReceiverWithdrawals  collections.KeySet[collections.Pair[string, uint64]]      // (receiver, l2 sequence)
+	DepositRecords       collections.Map[uint64, types.DepositRecord]              // l1 sequence -> deposit record
+	FailedDeposits       collections.KeySet[uint64]                                // l1 sequence
+	BaseFeeMultiplier    collections.Item[math.LegacyDec]
+	BlockGasUsed         collections.Item[uint64]
+	Sponsorships         collections.Map[collections.Pair[string, string], types.Sponsorship] // (account, msg type url) -> sponsorship
+	SponsorshipUsages    collections.Map[collections.Triple[string, uint64, string], uint64]  // (msg type url, period start, fee payer) -> gas used
+	FeeDistributions     collections.Map[string, types.FeeDistributionTotal]                  // recipient -> accumulated distributed fees
+
+	// ExecutorChangePlans holds the executor change plans registered without context, e.g. by an
+	// upgrade handler at the app construction; they are persisted to ExecutorChanges at the next
+	// end block.
 	ExecutorChangePlans map[uint64]types.ExecutorChangePlan
 
 	l2OracleHandler    *L2OracleHandler
@@ -140,6 +168,29 @@ func NewKeeper(
 		MigrationInfos:        collections.NewMap(sb, types.MigrationInfoPrefix, "migration_infos", collections.StringKey, codec.CollValue[types.MigrationInfo](cdc)),
 		IBCToL2DenomMap:       collections.NewMap(sb, types.IBCToL2DenomMapPrefix, "ibc_to_l2_denom_map", collections.StringKey, collections.StringValue),
 		ShutdownInfo:          collections.NewItem(sb, types.ShutdownInfoPrefix, "shutdown_info", codec.CollValue[types.ShutdownInfo](cdc)),
+		MigrationStatuses:     collections.NewMap(sb, types.MigrationStatusPrefix, "migration_statuses", collections.StringKey, codec.CollValue[types.MigrationStatus](cdc)),
+		AutoMigrations:        collections.NewMap(sb, types.AutoMigrationPrefix, "auto_migrations", collections.StringKey, codec.CollValue[types.AutoMigration](cdc)),
+		OPinitChannelId:       collections.NewItem(sb, types.OPinitChannelKey, "opinit_channel_id", collections.StringValue),
+		LastStatusReport:      collections.NewItem(sb, types.StatusReportKey, "last_status_report", collections.Uint64Value),
+		PendingDeposits:       collections.NewMap(sb, types.PendingDepositPrefix, "pending_deposits", collections.Uint64Key, codec.CollValue[types.PendingDeposit](cdc)),
+		DepositVotes:          collections.NewMap(sb, types.DepositVotePrefix, "deposit_votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BytesValue),
+		BridgeInfoVotes:       collections.NewMap(sb, types.BridgeInfoVotePrefix, "bridge_info_votes", collections.BytesKey, collections.BytesValue),
+		ExecutorChanges:       collections.NewMap(sb, types.ExecutorChangePlanPrefix, "executor_change_plans", collections.Uint64Key, codec.CollValue[types.ExecutorChangePlan](cdc)),
+		Sequencers:            collections.NewMap(sb, types.SequencersPrefix, "sequencers", collections.BytesKey, codec.CollValue[types.Sequencer](cdc)),
+		ForcedTxs:             collections.NewMap(sb, types.ForcedTxPrefix, "forced_txs", collections.Uint64Key, codec.CollValue[ophosttypes.ForcedTx](cdc)),
+		NextForcedTxSequence:  collections.NewSequence(sb, types.NextForcedTxSequenceKey, "next_forced_tx_sequence"),
+		WithdrawalTree:        collections.NewMap(sb, types.WithdrawalTreePrefix, "withdrawal_tree", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.BytesValue),
+		WithdrawalRecords:     collections.NewMap(sb, types.WithdrawalRecordPrefix, "withdrawal_records", collections.Uint64Key, codec.CollValue[types.WithdrawalRecord](cdc)),
+		BlockHashes:           collections.NewMap(sb, types.BlockHashPrefix, "block_hashes", collections.Uint64Key, collections.BytesValue),
+		SenderWithdrawals:     collections.NewKeySet(sb, types.WithdrawalRecordsBySenderPrefix, "sender_withdrawals", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		ReceiverWithdrawals:   collections.NewKeySet(sb, types.WithdrawalRecordsByReceiverPrefix, "receiver_withdrawals", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		DepositRecords:        collections.NewMap(sb, types.DepositRecordPrefix, "deposit_records", collections.Uint64Key, codec.CollValue[types.DepositRecord](cdc)),
+		FailedDeposits:        collections.NewKeySet(sb, types.FailedDepositRecordPrefix, "failed_deposits", collections.Uint64Key),
+		BaseFeeMultiplier:     collections.NewItem(sb, types.BaseFeeMultiplierKey, "base_fee_multiplier", sdk.LegacyDecValue),
+		BlockGasUsed:          collections.NewItem(sb, types.BlockGasUsedKey, "block_gas_used", collections.Uint64Value),
+		Sponsorships:          collections.NewMap(sb, types.SponsorshipPrefix, "sponsorships", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.Sponsorship](cdc)),
+		SponsorshipUsages:     collections.NewMap(sb, types.SponsorshipUsagePrefix, "sponsorship_usages", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.StringKey), collections.Uint64Value),
+		FeeDistributions:      collections.NewMap(sb, types.FeeDistributionPrefix, "fee_distributions", collections.StringKey, codec.CollValue[types.FeeDistributionTotal](cdc)),
 		ExecutorChangePlans:   make(map[uint64]types.ExecutorChangePlan),
 		HostValidatorStore:    hostValidatorStore,
 	}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// MEVRewardsEscrowAddress returns the address which holds the MEV auction proceeds until they are
+// split at the end of the block.
+func MEVRewardsEscrowAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.MEVRewardsEscrowName)
+}
+
+// MEVRewardsAddress returns the address to which the MEV auction proceeds are sent. The proceeds
+// go to the escrow address when params.MevRewardsRatio is set, and to the fee collector otherwise.
+func (k Keeper) MEVRewardsAddress(ctx context.Context) (sdk.AccAddress, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.MevRewardsRatio.IsNil() || !params.MevRewardsRatio.IsPositive() {
+		return authtypes.NewModuleAddress(authtypes.FeeCollectorName), nil
+	}
+
+	return MEVRewardsEscrowAddress(), nil
+}
+
+// DistributeMEVRewards splits the MEV auction proceeds in the escrow address by
+// params.MevRewardsRatio, sending the share to params.MevRewardsAddress and the rest to the fee
+// collector.
+func (k Keeper) DistributeMEVRewards(ctx context.Context) error {
+	escrow := MEVRewardsEscrowAddress()
+	proceeds := k.bankKeeper.GetAllBalances(ctx, escrow)
+	if proceeds.IsZero() {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.MevRewardsRatio.IsNil() && params.MevRewardsRatio.IsPositive() {
+		share, _ := sdk.NewDecCoinsFromCoins(proceeds...).MulDecTruncate(params.MevRewardsRatio).TruncateDecimal()
+		if !share.IsZero() {
+			recipient, err := k.authKeeper.AddressCodec().StringToBytes(params.MevRewardsAddress)
+			if err != nil {
+				return err
+			}
+
+			if err := k.bankKeeper.SendCoins(ctx, escrow, recipient, share); err != nil {
+				return err
+			}
+			if err := k.recordFeeDistribution(ctx, params.MevRewardsAddress, share); err != nil {
+				return err
+			}
+
+			proceeds = proceeds.Sub(share...)
+		}
+	}
+
+	if proceeds.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, escrow, authtypes.FeeCollectorName, proceeds)
+}
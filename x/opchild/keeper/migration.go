@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -65,6 +66,30 @@ func (k Keeper) HasIBCToL2DenomMap(ctx context.Context, ibcDenom string) (bool,
 	return k.IBCToL2DenomMap.Has(ctx, ibcDenom)
 }
 
+// GetMigrationStatus returns the migration counters of the OP token, which are zero before
+// the first migration.
+func (k Keeper) GetMigrationStatus(ctx context.Context, denom string) (types.MigrationStatus, error) {
+	migrationStatus, err := k.MigrationStatuses.Get(ctx, denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.NewMigrationStatus(denom), nil
+	}
+
+	return migrationStatus, err
+}
+
+// increaseMigrationStatus adds the migrated and the unmigrated amount of the OP token to the
+// migration counters.
+func (k Keeper) increaseMigrationStatus(ctx context.Context, denom string, migrated, unmigrated math.Int) error {
+	migrationStatus, err := k.GetMigrationStatus(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	migrationStatus.Migrated.Amount = migrationStatus.Migrated.Amount.Add(migrated)
+	migrationStatus.Unmigrated.Amount = migrationStatus.Unmigrated.Amount.Add(unmigrated)
+	return k.MigrationStatuses.Set(ctx, denom, migrationStatus)
+}
+
 // MigrateToken implements migrating a token from the OP token to the IBC token
 func (k Keeper) MigrateToken(ctx context.Context, migrationInfo types.MigrationInfo, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
 	// check if the amount is positive
@@ -109,9 +134,69 @@ func (k Keeper) MigrateToken(ctx context.Context, migrationInfo types.MigrationI
 		return sdk.Coin{}, err
 	}
 
+	if err := k.increaseMigrationStatus(ctx, amount.Denom, amount.Amount, math.ZeroInt()); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	return ibcCoin, nil
 }
 
+// UnmigrateToken implements converting the migrated IBC token back to the OP token
+func (k Keeper) UnmigrateToken(ctx context.Context, sender sdk.AccAddress, ibcCoin sdk.Coin) (sdk.Coin, error) {
+	// check if the amount is positive
+	if !ibcCoin.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount is not positive")
+	}
+
+	// compute l2Denom
+	l2Denom, err := k.GetIBCToL2DenomMap(ctx, ibcCoin.Denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "migration info not found for %s", ibcCoin.Denom)
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	migrationInfo, err := k.GetMigrationInfo(ctx, l2Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !migrationInfo.UnmigrationEnabled {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unmigration is not enabled for %s", l2Denom)
+	}
+
+	// send IBC token to the module
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(ibcCoin))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// burn IBC token
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(ibcCoin))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// mint L2 token
+	l2Coin := sdk.NewCoin(l2Denom, ibcCoin.Amount)
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(l2Coin))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// send L2 token to the sender
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(l2Coin))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.increaseMigrationStatus(ctx, l2Denom, math.ZeroInt(), l2Coin.Amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return l2Coin, nil
+}
+
 // HandleMigratedTokenDeposit implements handling a migrated token deposit: convert IBC token to L2 token
 func (k Keeper) HandleMigratedTokenDeposit(ctx context.Context, sender sdk.AccAddress, ibcCoin sdk.Coin, memo string) (sdk.Coin, error) {
 	// check if the amount is positive
@@ -150,6 +235,10 @@ func (k Keeper) HandleMigratedTokenDeposit(ctx context.Context, sender sdk.AccAd
 		return sdk.Coin{}, err
 	}
 
+	if err := k.increaseMigrationStatus(ctx, l2Denom, math.ZeroInt(), l2Coin.Amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	// handle deposit hook if exists
 	// if the memo is not valid, return the L2 token
 	var migratedTokenDepositMemo ophosttypes.MigratedTokenDepositMemo
@@ -163,12 +252,23 @@ func (k Keeper) HandleMigratedTokenDeposit(ctx context.Context, sender sdk.AccAd
 			return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "hook data size exceeds maximum limit")
 		}
 
+		// the unsigned route would run on behalf of the receiver, which is chosen by the sender
+		// of the transfer; only signed txs are allowed on the migration path
+		if _, ok := ophosttypes.ParseDepositRouteMemo(migratedTokenDepositMemo.OPinit); ok {
+			return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "deposit route is not supported for migrated tokens")
+		}
+
 		params, err := k.GetParams(ctx)
 		if err != nil {
 			return sdk.Coin{}, err
 		}
 
-		if success, reason := k.handleBridgeHook(sdk.UnwrapSDKContext(ctx), migratedTokenDepositMemo.OPinit, params.HookMaxGas); !success {
+		senderStr, err := k.addressCodec.BytesToString(sender)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if success, reason := k.handleBridgeHook(sdk.UnwrapSDKContext(ctx), senderStr, senderStr, l2Coin, migratedTokenDepositMemo.OPinit, params.HookMaxGas); !success {
 			return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, reason)
 		}
 	}
@@ -184,6 +284,9 @@ func (k Keeper) HandleMigratedTokenWithdrawal(ctx context.Context, msg *types.Ms
 		return false, nil
 	} else if err != nil {
 		return false, err
+	} else if migrationInfo.MigrationPaused {
+		// the withdrawals are made through the OP bridge while the migration is paused
+		return false, nil
 	}
 
 	sender, err := k.addressCodec.StringToBytes(msg.Sender)
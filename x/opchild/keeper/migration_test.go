@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -18,6 +20,7 @@ import (
 	"github.com/initia-labs/OPinit/x/opchild/keeper"
 	"github.com/initia-labs/OPinit/x/opchild/testutil"
 	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 // Test_RegisterMigrationInfo_Success tests successful registration of migration info
@@ -956,6 +959,42 @@ func Test_HandleMigratedTokenDeposit_InsufficientBalance(t *testing.T) {
 	require.Contains(t, err.Error(), "insufficient funds")
 }
 
+// Test_HandleMigratedTokenDeposit_DepositRoute tests the unsigned deposit route is rejected on the migration path
+func Test_HandleMigratedTokenDeposit_DepositRoute(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	err := input.OPChildKeeper.DenomPairs.Set(ctx, "test1", "test1")
+	require.NoError(t, err)
+
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	migrationInfo := opchildtypes.MigrationInfo{
+		Denom:        "test1",
+		IbcChannelId: "channel-0",
+		IbcPortId:    "transfer",
+	}
+
+	_, err = ms.RegisterMigrationInfo(ctx, opchildtypes.NewMsgRegisterMigrationInfo(
+		authtypes.NewModuleAddress(opchildtypes.ModuleName).String(),
+		migrationInfo,
+	))
+	require.NoError(t, err)
+
+	sender := testutil.Addrs[0]
+	ibcAmount := sdk.NewCoin("ibc/1234567890ABCDEF", math.NewInt(100))
+	err = input.OPChildKeeper.SetIBCToL2DenomMap(ctx, "ibc/1234567890ABCDEF", "test1")
+	require.NoError(t, err)
+	input.Faucet.Fund(ctx, sender, ibcAmount)
+
+	memo, err := json.Marshal(ophosttypes.MigratedTokenDepositMemo{
+		OPinit: []byte(`{"route":{"ibc_transfer":{"source_port":"transfer","source_channel":"channel-1","receiver":"cosmos1receiver"}}}`),
+	})
+	require.NoError(t, err)
+
+	_, err = ms.HandleMigratedTokenDeposit(ctx, sender, ibcAmount, string(memo))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "deposit route is not supported")
+}
+
 // Test_HandleMigratedTokenDeposit_CompleteFlow tests the complete flow: migrate then handle migrated token deposit
 func Test_HandleMigratedTokenDeposit_CompleteFlow(t *testing.T) {
 	ctx, input := testutil.CreateTestInput(t, false)
@@ -1089,3 +1128,166 @@ func Test_HandleMigratedTokenDeposit_MultipleDenoms(t *testing.T) {
 	require.Equal(t, math.NewInt(100), l2Balance1.Amount)
 	require.Equal(t, math.NewInt(200), l2Balance2.Amount)
 }
+
+// Test_UnmigrateToken tests converting the migrated IBC token back to the OP token
+func Test_UnmigrateToken(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	// Set up denom pair first (L1 token)
+	err := input.OPChildKeeper.DenomPairs.Set(ctx, "test1", "test1")
+	require.NoError(t, err)
+
+	// Register migration info first
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	authority := authtypes.NewModuleAddress(opchildtypes.ModuleName).String()
+	migrationInfo := opchildtypes.MigrationInfo{
+		Denom:        "test1",
+		IbcChannelId: "channel-0",
+		IbcPortId:    "transfer",
+	}
+
+	_, err = ms.RegisterMigrationInfo(ctx, opchildtypes.NewMsgRegisterMigrationInfo(authority, migrationInfo))
+	require.NoError(t, err)
+
+	// Fund the sender account with the IBC token
+	sender := testutil.Addrs[0]
+	ibcCoin := transfertypes.GetTransferCoin(migrationInfo.IbcPortId, migrationInfo.IbcChannelId, "test1", math.NewInt(100))
+	input.Faucet.Fund(ctx, sender, ibcCoin)
+
+	// unmigration is disabled by default
+	_, err = ms.UnmigrateToken(ctx, opchildtypes.NewMsgUnmigrateToken(sender.String(), ibcCoin))
+	require.Error(t, err)
+
+	// unknown IBC denom
+	_, err = ms.UnmigrateToken(ctx, opchildtypes.NewMsgUnmigrateToken(sender.String(), sdk.NewCoin("ibc/unknown", math.NewInt(100))))
+	require.Error(t, err)
+
+	// only the authority can update the toggles
+	_, err = ms.UpdateMigrationToggles(ctx, opchildtypes.NewMsgUpdateMigrationToggles(sender.String(), "test1", true, false, false))
+	require.Error(t, err)
+
+	// unknown denom
+	_, err = ms.UpdateMigrationToggles(ctx, opchildtypes.NewMsgUpdateMigrationToggles(authority, "test2", true, false, false))
+	require.Error(t, err)
+
+	_, err = ms.UpdateMigrationToggles(ctx, opchildtypes.NewMsgUpdateMigrationToggles(authority, "test1", true, false, false))
+	require.NoError(t, err)
+
+	info, err := input.OPChildKeeper.GetMigrationInfo(ctx, "test1")
+	require.NoError(t, err)
+	require.True(t, info.UnmigrationEnabled)
+	require.False(t, info.MigrationPaused)
+
+	_, err = ms.UnmigrateToken(ctx, opchildtypes.NewMsgUnmigrateToken(sender.String(), ibcCoin))
+	require.NoError(t, err)
+
+	// the IBC token is burned and the OP token is minted
+	require.Equal(t, math.NewInt(0), input.BankKeeper.GetBalance(ctx, sender, ibcCoin.Denom).Amount)
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, sender, "test1").Amount)
+	require.Equal(t, math.NewInt(0), input.BankKeeper.GetSupply(ctx, ibcCoin.Denom).Amount)
+
+	// insufficient balance
+	_, err = ms.UnmigrateToken(ctx, opchildtypes.NewMsgUnmigrateToken(sender.String(), ibcCoin))
+	require.Error(t, err)
+}
+
+// Test_UpdateMigrationToggles_PauseMigration tests the OP bridge fallback while the migration is paused
+func Test_UpdateMigrationToggles_PauseMigration(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	// Set up denom pair first (L1 token)
+	err := input.OPChildKeeper.DenomPairs.Set(ctx, "test1", "test1")
+	require.NoError(t, err)
+
+	// Register migration info first
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	authority := authtypes.NewModuleAddress(opchildtypes.ModuleName).String()
+	migrationInfo := opchildtypes.MigrationInfo{
+		Denom:        "test1",
+		IbcChannelId: "channel-0",
+		IbcPortId:    "transfer",
+	}
+
+	_, err = ms.RegisterMigrationInfo(ctx, opchildtypes.NewMsgRegisterMigrationInfo(authority, migrationInfo))
+	require.NoError(t, err)
+
+	_, err = ms.UpdateMigrationToggles(ctx, opchildtypes.NewMsgUpdateMigrationToggles(authority, "test1", false, true, false))
+	require.NoError(t, err)
+
+	// Fund the sender account
+	sender := testutil.Addrs[0]
+	amount := sdk.NewCoin("test1", math.NewInt(100))
+	input.Faucet.Fund(ctx, sender, amount)
+
+	// the manual migration is rejected
+	_, err = ms.MigrateToken(ctx, opchildtypes.NewMsgMigrateToken(sender.String(), amount))
+	require.Error(t, err)
+
+	// the withdrawal is left to the OP bridge
+	handled, err := input.OPChildKeeper.HandleMigratedTokenWithdrawal(ctx, opchildtypes.NewMsgInitiateTokenWithdrawal(sender.String(), testutil.Addrs[1].String(), amount))
+	require.NoError(t, err)
+	require.False(t, handled)
+	require.Empty(t, input.MockRouter.GetHandledMsgs())
+	require.Equal(t, amount, input.BankKeeper.GetBalance(ctx, sender, "test1"))
+
+	// resume the migration
+	_, err = ms.UpdateMigrationToggles(ctx, opchildtypes.NewMsgUpdateMigrationToggles(authority, "test1", false, false, false))
+	require.NoError(t, err)
+
+	_, err = ms.MigrateToken(ctx, opchildtypes.NewMsgMigrateToken(sender.String(), amount))
+	require.NoError(t, err)
+}
+
+// Test_MigrationStatus tests the migration counters and the supply reconciliation
+func Test_MigrationStatus(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	// Set up denom pair first (L1 token)
+	err := input.OPChildKeeper.DenomPairs.Set(ctx, "test1", "test1")
+	require.NoError(t, err)
+
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	querier := keeper.NewQuerier(&input.OPChildKeeper)
+
+	// migration info not found
+	_, err = querier.MigrationStatus(ctx, &opchildtypes.QueryMigrationStatusRequest{Denom: "test1"})
+	require.Error(t, err)
+
+	authority := authtypes.NewModuleAddress(opchildtypes.ModuleName).String()
+	migrationInfo := opchildtypes.MigrationInfo{
+		Denom:        "test1",
+		IbcChannelId: "channel-0",
+		IbcPortId:    "transfer",
+	}
+	_, err = ms.RegisterMigrationInfo(ctx, opchildtypes.NewMsgRegisterMigrationInfo(authority, migrationInfo))
+	require.NoError(t, err)
+	_, err = ms.UpdateMigrationToggles(ctx, opchildtypes.NewMsgUpdateMigrationToggles(authority, "test1", true, false, false))
+	require.NoError(t, err)
+
+	// Fund the sender account
+	sender := testutil.Addrs[0]
+	input.Faucet.Fund(ctx, sender, sdk.NewCoin("test1", math.NewInt(100)))
+
+	// no migration yet
+	res, err := querier.MigrationStatus(ctx, &opchildtypes.QueryMigrationStatusRequest{Denom: "test1"})
+	require.NoError(t, err)
+	require.Equal(t, opchildtypes.NewMigrationStatus("test1"), res.MigrationStatus)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(100)), res.Remaining)
+
+	_, err = ms.MigrateToken(ctx, opchildtypes.NewMsgMigrateToken(sender.String(), sdk.NewCoin("test1", math.NewInt(60))))
+	require.NoError(t, err)
+
+	_, err = ms.UnmigrateToken(ctx, opchildtypes.NewMsgUnmigrateToken(sender.String(), sdk.NewCoin(res.IbcDenom, math.NewInt(20))))
+	require.NoError(t, err)
+
+	res, err = querier.MigrationStatus(ctx, &opchildtypes.QueryMigrationStatusRequest{Denom: "test1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(60)), res.MigrationStatus.Migrated)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(20)), res.MigrationStatus.Unmigrated)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(60)), res.Remaining)
+	require.Equal(t, sdk.NewCoin(res.IbcDenom, math.NewInt(40)), res.IbcSupply)
+
+	// the counters are exported to the genesis
+	genState := input.OPChildKeeper.ExportGenesis(ctx)
+	require.Equal(t, []opchildtypes.MigrationStatus{res.MigrationStatus}, genState.MigrationStatuses)
+}
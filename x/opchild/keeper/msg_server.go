@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
@@ -155,6 +156,17 @@ func (ms MsgServer) UpdateSequencer(ctx context.Context, req *types.MsgUpdateSeq
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
 	}
 
+	// replace the whole sequencer set with the new sequencer
+	if sequencers, err := ms.GetSequencers(ctx); err != nil {
+		return nil, err
+	} else if len(sequencers) > 0 {
+		if err := ms.clearSequencers(ctx, sequencers); err != nil {
+			return nil, err
+		}
+
+		return ms.addSequencerValidator(ctx, req)
+	}
+
 	// find the existing sequencer and remove it
 	var seqAddr sdk.ValAddress
 	if err := ms.IterateValidators(ctx, func(validator types.ValidatorI) (stop bool, err error) {
@@ -175,7 +187,11 @@ func (ms MsgServer) UpdateSequencer(ctx context.Context, req *types.MsgUpdateSeq
 		return nil, err
 	}
 
-	// add the new sequencer
+	return ms.addSequencerValidator(ctx, req)
+}
+
+// addSequencerValidator adds the new sequencer of MsgUpdateSequencer to the validator set
+func (ms MsgServer) addSequencerValidator(ctx context.Context, req *types.MsgUpdateSequencer) (*types.MsgUpdateSequencerResponse, error) {
 	newSeqAddr, err := ms.validatorAddressCodec.StringToBytes(req.SequencerAddress)
 	if err != nil {
 		return nil, err
@@ -187,6 +203,72 @@ func (ms MsgServer) UpdateSequencer(ctx context.Context, req *types.MsgUpdateSeq
 	return &types.MsgUpdateSequencerResponse{}, nil
 }
 
+// clearSequencers removes all the sequencers from the sequencer set and the validator set
+func (ms MsgServer) clearSequencers(ctx context.Context, sequencers []types.Sequencer) error {
+	for _, sequencer := range sequencers {
+		valAddr, err := ms.validatorAddressCodec.StringToBytes(sequencer.OperatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if validator, found := ms.GetValidator(ctx, valAddr); found && validator.ConsPower > 0 {
+			if err := ms.removeValidator(ctx, valAddr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ms.Sequencers.Clear(ctx, nil)
+}
+
+// AddSequencer implements adding a sequencer to the sequencer set
+func (ms MsgServer) AddSequencer(ctx context.Context, req *types.MsgAddSequencer) (*types.MsgAddSequencerResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec(), ms.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	if _, ok := req.Pubkey.GetCachedValue().(cryptotypes.PubKey); !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", req.Pubkey.GetCachedValue())
+	}
+
+	if err := ms.Keeper.AddSequencer(ctx, types.Sequencer{
+		Moniker:         req.Moniker,
+		OperatorAddress: req.SequencerAddress,
+		ConsensusPubkey: req.Pubkey,
+		Weight:          req.Weight,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddSequencerResponse{}, nil
+}
+
+// RemoveSequencer implements removing a sequencer from the sequencer set
+func (ms MsgServer) RemoveSequencer(ctx context.Context, req *types.MsgRemoveSequencer) (*types.MsgRemoveSequencerResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec(), ms.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	valAddr, err := ms.validatorAddressCodec.StringToBytes(req.SequencerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.RemoveSequencer(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveSequencerResponse{}, nil
+}
+
 // addValidator implements adding a validator to the designated validator set
 func (ms MsgServer) addValidator(ctx context.Context, moniker string, valAddr sdk.ValAddress, pubkey *codectypes.Any, vp int64) error {
 	pk, ok := pubkey.GetCachedValue().(cryptotypes.PubKey)
@@ -497,6 +579,176 @@ func (ms MsgServer) SpendFeePool(ctx context.Context, req *types.MsgSpendFeePool
 	return &types.MsgSpendFeePoolResponse{}, nil
 }
 
+// checkAuthorityOrAdminPermission checks if the sender is the authority or the admin
+func (ms MsgServer) checkAuthorityOrAdminPermission(ctx context.Context, sender string) error {
+	if ms.authority == sender {
+		return nil
+	}
+
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.Admin != sender {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s or %s, got %s", ms.authority, params.Admin, sender)
+	}
+
+	return nil
+}
+
+// ScheduleExecutorChange implements scheduling the change of the sequencer and the bridge executors
+func (ms MsgServer) ScheduleExecutorChange(ctx context.Context, req *types.MsgScheduleExecutorChange) (*types.MsgScheduleExecutorChangeResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec(), ms.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkAuthorityOrAdminPermission(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if req.Height <= uint64(sdkCtx.BlockHeight()) { //nolint:gosec
+		return nil, errorsmod.Wrapf(types.ErrInvalidExecutorChangePlan, "height %d must be greater than the current height %d", req.Height, sdkCtx.BlockHeight())
+	}
+
+	valAddr, err := ms.validatorAddressCodec.StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, ok := req.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	validator, err := types.NewValidator(valAddr, pk, req.Moniker)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.SetExecutorChangePlan(ctx, types.ExecutorChangePlan{
+		ProposalID:    req.ProposalID,
+		Height:        req.Height,
+		NextExecutors: req.NextExecutors,
+		NextValidator: validator,
+		Info:          req.Info,
+	}); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleExecutorChange,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyProposalId, strconv.FormatUint(req.ProposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(req.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidator, req.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyNextExecutors, strings.Join(req.NextExecutors, ",")),
+		),
+	)
+
+	return &types.MsgScheduleExecutorChangeResponse{}, nil
+}
+
+// CancelExecutorChange implements canceling the scheduled executor change
+func (ms MsgServer) CancelExecutorChange(ctx context.Context, req *types.MsgCancelExecutorChange) (*types.MsgCancelExecutorChangeResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkAuthorityOrAdminPermission(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	if found, err := ms.ExecutorChanges.Has(ctx, req.Height); err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errorsmod.Wrapf(types.ErrExecutorChangePlanNotFound, "height %d", req.Height)
+	}
+
+	if err := ms.ExecutorChanges.Remove(ctx, req.Height); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelExecutorChange,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(req.Height, 10)),
+		),
+	)
+
+	return &types.MsgCancelExecutorChangeResponse{}, nil
+}
+
+// RegisterSponsorship implements registering or replacing the gas budget sponsorship
+func (ms MsgServer) RegisterSponsorship(ctx context.Context, req *types.MsgRegisterSponsorship) (*types.MsgRegisterSponsorshipResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkAuthorityOrAdminPermission(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.RegisterSponsorship(ctx, req.Account, req.MsgTypeUrl, req.GasBudget, req.Period, req.AccountGasLimit); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterSponsorship,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyAccount, req.Account),
+			sdk.NewAttribute(types.AttributeKeyMsgType, req.MsgTypeUrl),
+			sdk.NewAttribute(types.AttributeKeyGasBudget, strconv.FormatUint(req.GasBudget, 10)),
+			sdk.NewAttribute(types.AttributeKeyPeriod, strconv.FormatUint(req.Period, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccountGasLimit, strconv.FormatUint(req.AccountGasLimit, 10)),
+		),
+	)
+
+	return &types.MsgRegisterSponsorshipResponse{}, nil
+}
+
+// RemoveSponsorship implements removing the gas budget sponsorship
+func (ms MsgServer) RemoveSponsorship(ctx context.Context, req *types.MsgRemoveSponsorship) (*types.MsgRemoveSponsorshipResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkAuthorityOrAdminPermission(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(req.Account, req.MsgTypeUrl)
+	if found, err := ms.Sponsorships.Has(ctx, key); err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errorsmod.Wrapf(types.ErrSponsorshipNotFound, "account %s, msg type url %s", req.Account, req.MsgTypeUrl)
+	}
+
+	if err := ms.Sponsorships.Remove(ctx, key); err != nil {
+		return nil, err
+	} else if req.Account == "" {
+		// the usages of the fee payers are only kept for the sponsorship of any fee payer
+		if err := ms.SponsorshipUsages.Clear(ctx, collections.NewPrefixedTripleRange[string, uint64, string](req.MsgTypeUrl)); err != nil {
+			return nil, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveSponsorship,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyAccount, req.Account),
+			sdk.NewAttribute(types.AttributeKeyMsgType, req.MsgTypeUrl),
+		),
+	)
+
+	return &types.MsgRemoveSponsorshipResponse{}, nil
+}
+
 /////////////////////////////////////////////////////
 // The messages for Bridge Executor
 
@@ -536,6 +788,13 @@ func (ms MsgServer) SetBridgeInfo(ctx context.Context, req *types.MsgSetBridgeIn
 		}
 	}
 
+	// wait for the other bridge executors to submit the identical bridge info
+	if ok, err := ms.voteBridgeInfo(ctx, req); err != nil {
+		return nil, err
+	} else if !ok {
+		return &types.MsgSetBridgeInfoResponse{}, nil
+	}
+
 	// set bridge info
 	if err := ms.BridgeInfo.Set(ctx, req.BridgeInfo); err != nil {
 		return nil, err
@@ -562,9 +821,6 @@ func (ms MsgServer) FinalizeTokenDeposit(ctx context.Context, req *types.MsgFina
 		return nil, err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	coin := req.Amount
-
 	// permission check
 	if err := ms.checkBridgeExecutorPermission(ctx, req.Sender); err != nil {
 		return nil, err
@@ -578,10 +834,55 @@ func (ms MsgServer) FinalizeTokenDeposit(ctx context.Context, req *types.MsgFina
 	if req.Sequence < finalizedL1Sequence {
 		// No op instead of returning an error
 		return &types.MsgFinalizeTokenDepositResponse{Result: types.NOOP}, nil
-	} else if req.Sequence > finalizedL1Sequence {
-		return nil, types.ErrInvalidSequence
 	}
 
+	// no vote is recorded for the deposit which is already buffered
+	if found, err := ms.PendingDeposits.Has(ctx, req.Sequence); err != nil {
+		return nil, err
+	} else if found {
+		return &types.MsgFinalizeTokenDepositResponse{Result: types.NOOP}, nil
+	}
+
+	// wait for the other bridge executors to submit the identical deposit
+	if ok, err := ms.voteDeposit(ctx, req, finalizedL1Sequence); err != nil {
+		return nil, err
+	} else if !ok {
+		return &types.MsgFinalizeTokenDepositResponse{Result: types.VOTED}, nil
+	}
+
+	result, err := ms.handleDeposit(ctx, req, finalizedL1Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFinalizeTokenDepositResponse{Result: result}, nil
+}
+
+// handleDeposit finalizes the authorized deposit, or buffers it if the deposit is ahead of
+// the next l1 sequence.
+func (ms MsgServer) handleDeposit(ctx context.Context, req *types.MsgFinalizeTokenDeposit, nextL1Sequence uint64) (types.ResponseResultType, error) {
+	if req.Sequence > nextL1Sequence {
+		// buffer the deposit until the previous deposits are finalized
+		return ms.bufferPendingDeposit(ctx, req, nextL1Sequence)
+	}
+
+	if err := ms.finalizeTokenDeposit(ctx, req); err != nil {
+		return types.UNSPECIFIED, err
+	}
+
+	// apply the buffered deposits following the finalized one
+	if err := ms.applyPendingDeposits(ctx); err != nil {
+		return types.UNSPECIFIED, err
+	}
+
+	return types.SUCCESS, nil
+}
+
+// finalizeTokenDeposit finalizes the deposit of the next l1 sequence and increases the sequence.
+func (ms MsgServer) finalizeTokenDeposit(ctx context.Context, req *types.MsgFinalizeTokenDeposit) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	coin := req.Amount
+
 	// deposit token
 	var depositSuccess bool
 	var reason string
@@ -596,7 +897,12 @@ func (ms MsgServer) FinalizeTokenDeposit(ctx context.Context, req *types.MsgFina
 
 	// update l1 sequence
 	if _, err := ms.IncreaseNextL1Sequence(ctx); err != nil {
-		return nil, err
+		return err
+	}
+
+	// votes on the finalized sequence are no longer counted
+	if err := ms.clearDepositVotes(ctx, req.Sequence); err != nil {
+		return err
 	}
 
 	// register denom metadata
@@ -606,16 +912,16 @@ func (ms MsgServer) FinalizeTokenDeposit(ctx context.Context, req *types.MsgFina
 
 	// register denom pair
 	if ok, err := ms.DenomPairs.Has(ctx, coin.Denom); err != nil {
-		return nil, err
+		return err
 	} else if !ok {
 		if err := ms.DenomPairs.Set(ctx, coin.Denom, req.BaseDenom); err != nil {
-			return nil, err
+			return err
 		}
 
 		// create token if it doesn't exist
 		if ms.tokenCreationFn != nil {
 			if err := ms.tokenCreationFn(ctx, coin.Denom, 0); err != nil {
-				return nil, err
+				return err
 			}
 		}
 	}
@@ -633,20 +939,33 @@ func (ms MsgServer) FinalizeTokenDeposit(ctx context.Context, req *types.MsgFina
 
 	params, err := ms.GetParams(ctx)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	record := types.DepositRecord{
+		Sequence:  req.Sequence,
+		From:      req.From,
+		To:        req.To,
+		Amount:    coin,
+		BaseDenom: req.BaseDenom,
+		Success:   depositSuccess,
+		Reason:    truncateReason(reason, maxRecordReasonLength),
+		Height:    uint64(sdkCtx.BlockHeight()), //nolint:gosec
 	}
 
 	// if the deposit is successful and the data is not empty, execute the hook
 	if depositSuccess && len(req.Data) > 0 {
-		hookSuccess, reason := ms.handleBridgeHook(sdkCtx, req.Data, params.HookMaxGas)
+		hookSuccess, hookReason := ms.handleBridgeHook(sdkCtx, req.From, req.To, coin, req.Data, params.HookMaxGas)
+		record.HookExecuted, record.HookSuccess, record.HookReason = true, hookSuccess, truncateReason(hookReason, maxRecordReasonLength)
+
 		depositEvent = depositEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(hookSuccess)))
 		if !hookSuccess {
-			depositEvent = depositEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyReason, "hook failed; "+reason))
+			depositEvent = depositEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyReason, "hook failed; "+truncateReason(hookReason, maxEventReasonLength)))
 		}
 	} else {
 		depositEvent = depositEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(depositSuccess)))
 		if !depositSuccess {
-			depositEvent = depositEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyReason, "deposit failed; "+reason))
+			depositEvent = depositEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyReason, "deposit failed; "+truncateReason(reason, maxEventReasonLength)))
 		}
 	}
 
@@ -657,16 +976,18 @@ func (ms MsgServer) FinalizeTokenDeposit(ctx context.Context, req *types.MsgFina
 	if !depositSuccess && coin.IsPositive() {
 		l2Sequence, err := ms.IncreaseNextL2Sequence(ctx)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		err = ms.emitWithdrawEvents(ctx, types.NewMsgInitiateTokenWithdrawal(req.To, req.From, coin), l2Sequence)
 		if err != nil {
-			return nil, err
+			return err
 		}
+
+		record.RefundL2Sequence = l2Sequence
 	}
 
-	return &types.MsgFinalizeTokenDepositResponse{Result: types.SUCCESS}, nil
+	return ms.SetDepositRecord(ctx, record)
 }
 
 /////////////////////////////////////////////////////
@@ -686,6 +1007,35 @@ func (ms MsgServer) RelayOracleData(ctx context.Context, req *types.MsgRelayOrac
 	return &types.MsgRelayOracleDataResponse{}, nil
 }
 
+// FinalizeTokenDepositWithProof implements finalizing a deposit from L1 with the state proof
+// of the deposit commitment, which allows anyone to relay the deposit.
+func (ms MsgServer) FinalizeTokenDepositWithProof(ctx context.Context, req *types.MsgFinalizeTokenDepositWithProof) (*types.MsgFinalizeTokenDepositWithProofResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	finalizedL1Sequence, err := ms.GetNextL1Sequence(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Sequence < finalizedL1Sequence {
+		// No op instead of returning an error
+		return &types.MsgFinalizeTokenDepositWithProofResponse{Result: types.NOOP}, nil
+	}
+
+	if err := ms.verifyDepositProof(ctx, req); err != nil {
+		return nil, err
+	}
+
+	result, err := ms.handleDeposit(ctx, req.ToFinalizeTokenDeposit(), finalizedL1Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFinalizeTokenDepositWithProofResponse{Result: result}, nil
+}
+
 /////////////////////////////////////////////////////
 // The messages for User
 
@@ -742,6 +1092,11 @@ func (ms MsgServer) emitWithdrawEvents(ctx context.Context, req *types.MsgInitia
 		return err
 	}
 
+	// record the withdrawal to build the withdrawal proofs
+	if err := ms.recordWithdrawal(ctx, l2Sequence, req.Sender, req.To, coin, baseDenom); err != nil {
+		return err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeInitiateTokenWithdrawal,
@@ -865,6 +1220,8 @@ func (ms MsgServer) MigrateToken(ctx context.Context, req *types.MsgMigrateToken
 		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "migration info not found")
 	} else if err != nil {
 		return nil, err
+	} else if migrationInfo.MigrationPaused {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "migration is paused for %s", req.Amount.Denom)
 	}
 
 	senderAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.Sender)
@@ -888,3 +1245,74 @@ func (ms MsgServer) MigrateToken(ctx context.Context, req *types.MsgMigrateToken
 
 	return &types.MsgMigrateTokenResponse{}, nil
 }
+
+// UnmigrateToken implements converting the migrated IBC token back to the OP token
+func (ms MsgServer) UnmigrateToken(ctx context.Context, req *types.MsgUnmigrateToken) (*types.MsgUnmigrateTokenResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	l2Coin, err := ms.Keeper.UnmigrateToken(ctx, senderAddr, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnmigrateToken,
+		sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+		sdk.NewAttribute(types.AttributeKeyIbcDenom, req.Amount.Denom),
+		sdk.NewAttribute(types.AttributeKeyAmount, req.Amount.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyUnmigratedCoin, l2Coin.String()),
+	))
+
+	return &types.MsgUnmigrateTokenResponse{}, nil
+}
+
+// UpdateMigrationToggles implements updating the migration toggles of the denom
+func (ms MsgServer) UpdateMigrationToggles(ctx context.Context, req *types.MsgUpdateMigrationToggles) (*types.MsgUpdateMigrationTogglesResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	migrationInfo, err := ms.GetMigrationInfo(ctx, req.Denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "migration info not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	// restart the auto migration from the first account when it is enabled again
+	if req.AutoMigrate && !migrationInfo.AutoMigrate {
+		if err := ms.AutoMigrations.Remove(ctx, req.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	migrationInfo.UnmigrationEnabled = req.UnmigrationEnabled
+	migrationInfo.MigrationPaused = req.MigrationPaused
+	migrationInfo.AutoMigrate = req.AutoMigrate
+	if err := ms.SetMigrationInfo(ctx, migrationInfo); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateMigrationToggles,
+		sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+		sdk.NewAttribute(types.AttributeKeyUnmigration, strconv.FormatBool(req.UnmigrationEnabled)),
+		sdk.NewAttribute(types.AttributeKeyMigrationPaused, strconv.FormatBool(req.MigrationPaused)),
+		sdk.NewAttribute(types.AttributeKeyAutoMigrate, strconv.FormatBool(req.AutoMigrate)),
+	))
+
+	return &types.MsgUpdateMigrationTogglesResponse{}, nil
+}
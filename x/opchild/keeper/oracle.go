@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -176,6 +177,96 @@ func (k Keeper) HandleOracleDataPacket(
 	return nil
 }
 
+// OnRecvOraclePriceUpdatePacket is called when an oracle price update packet is received via IBC.
+func (k Keeper) OnRecvOraclePriceUpdatePacket(
+	ctx context.Context,
+	data ophosttypes.OraclePriceUpdatePacketData,
+) ([]byte, error) {
+	ack, err := k.HandleOraclePriceUpdatePacket(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	ackBytes, err := json.Marshal(ack)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, "failed to marshal acknowledgement")
+	}
+
+	return ackBytes, nil
+}
+
+// HandleOraclePriceUpdatePacket handles the oracle prices pushed from L1 over the opinit channel.
+// Unlike HandleOracleDataPacket, no proof is required because the packet is already verified
+// by the IBC light client of L1.
+func (k Keeper) HandleOraclePriceUpdatePacket(
+	ctx context.Context,
+	packet ophosttypes.OraclePriceUpdatePacketData,
+) (ophosttypes.OraclePriceUpdatePacketAck, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bridgeInfo, err := k.BridgeInfo.Get(ctx)
+	if err != nil {
+		return ophosttypes.OraclePriceUpdatePacketAck{
+			Success: false,
+			Error:   fmt.Sprintf("failed to get bridge info: %v", err),
+		}, nil
+	}
+
+	if packet.BridgeId != bridgeInfo.BridgeId {
+		return ophosttypes.OraclePriceUpdatePacketAck{
+			Success: false,
+			Error:   fmt.Sprintf("bridge ID mismatch: expected %d, got %d", bridgeInfo.BridgeId, packet.BridgeId),
+		}, nil
+	}
+
+	if !bridgeInfo.BridgeConfig.OracleEnabled {
+		return ophosttypes.OraclePriceUpdatePacketAck{
+			Success: false,
+			Error:   types.ErrOracleDisabled.Error(),
+		}, nil
+	}
+
+	if len(packet.Prices) == 0 {
+		return ophosttypes.OraclePriceUpdatePacketAck{
+			Success: false,
+			Error:   "no prices provided",
+		}, nil
+	}
+
+	oracleData := types.OracleData{
+		BridgeId:      packet.BridgeId,
+		Prices:        make([]types.OraclePriceData, len(packet.Prices)),
+		L1BlockHeight: packet.L1BlockHeight,
+		L1BlockTime:   packet.L1BlockTime,
+	}
+	for i, price := range packet.Prices {
+		oracleData.Prices[i] = types.OraclePriceData{
+			CurrencyPair:   price.CurrencyPair,
+			Price:          price.Price,
+			CurrencyPairId: price.CurrencyPairId,
+			Timestamp:      price.Timestamp,
+		}
+	}
+
+	if err := k.processBatchedOraclePriceUpdate(ctx, oracleData); err != nil {
+		return ophosttypes.OraclePriceUpdatePacketAck{
+			Success: false,
+			Error:   fmt.Sprintf("failed to process oracle prices: %v", err),
+		}, nil
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOraclePriceUpdate,
+			sdk.NewAttribute(types.AttributeKeyBridgeId, fmt.Sprintf("%d", packet.BridgeId)),
+			sdk.NewAttribute(types.AttributeKeyL1BlockHeight, fmt.Sprintf("%d", packet.L1BlockHeight)),
+			sdk.NewAttribute(types.AttributeKeyNumCurrencyPair, strconv.Itoa(len(packet.Prices))),
+		),
+	)
+
+	return ophosttypes.OraclePriceUpdatePacketAck{Success: true}, nil
+}
+
 // verifyOracleDataProof verifies the oracle hash proof from L1.
 // This verifies that the oracle price hash exists in L1's ophost module state
 // at the specified height using a Merkle proof against L1's state root.
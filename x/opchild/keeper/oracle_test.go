@@ -631,3 +631,74 @@ func Test_BatchedOracleRelay_WithIBCSetup(t *testing.T) {
 	}
 	require.True(t, found, "expected oracle data relay event to be emitted")
 }
+
+func Test_HandleOraclePriceUpdatePacket(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	input.OracleKeeper.InitGenesis(sdkCtx, oracletypes.GenesisState{
+		CurrencyPairGenesis: make([]oracletypes.CurrencyPairGenesis, 0),
+	})
+
+	cp, err := connecttypes.CurrencyPairFromString("BTC/USD")
+	require.NoError(t, err)
+	err = input.OracleKeeper.CreateCurrencyPair(sdkCtx, cp)
+	require.NoError(t, err)
+
+	bridgeInfo := types.BridgeInfo{
+		BridgeId:  1,
+		L1ChainId: "test-chain-1",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			OracleEnabled: true,
+		},
+	}
+	err = input.OPChildKeeper.BridgeInfo.Set(ctx, bridgeInfo)
+	require.NoError(t, err)
+
+	packet := ophosttypes.OraclePriceUpdatePacketData{
+		BridgeId: 1,
+		Prices: []ophosttypes.OraclePrice{
+			{CurrencyPairId: 0, CurrencyPair: "BTC/USD", Price: "10000000", Timestamp: 1000000000},
+		},
+		L1BlockHeight: 100,
+		L1BlockTime:   1000000000,
+	}
+
+	ack, err := input.OPChildKeeper.HandleOraclePriceUpdatePacket(ctx, packet)
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+
+	price, err := input.OracleKeeper.GetPriceForCurrencyPair(sdkCtx, cp)
+	require.NoError(t, err)
+	require.Equal(t, "10000000", price.Price.String())
+	require.Equal(t, int64(1000000000), price.BlockTimestamp.UnixNano())
+
+	// stale price is ignored
+	packet.Prices[0].Price = "9000000"
+	packet.Prices[0].Timestamp = 900000000
+	ack, err = input.OPChildKeeper.HandleOraclePriceUpdatePacket(ctx, packet)
+	require.NoError(t, err)
+	require.True(t, ack.Success)
+
+	price, err = input.OracleKeeper.GetPriceForCurrencyPair(sdkCtx, cp)
+	require.NoError(t, err)
+	require.Equal(t, "10000000", price.Price.String())
+
+	// bridge id mismatch
+	packet.BridgeId = 2
+	ack, err = input.OPChildKeeper.HandleOraclePriceUpdatePacket(ctx, packet)
+	require.NoError(t, err)
+	require.False(t, ack.Success)
+	require.Contains(t, ack.Error, "bridge ID mismatch")
+
+	// oracle disabled
+	bridgeInfo.BridgeConfig.OracleEnabled = false
+	err = input.OPChildKeeper.BridgeInfo.Set(ctx, bridgeInfo)
+	require.NoError(t, err)
+
+	packet.BridgeId = 1
+	ack, err = input.OPChildKeeper.HandleOraclePriceUpdatePacket(ctx, packet)
+	require.NoError(t, err)
+	require.False(t, ack.Success)
+	require.Contains(t, ack.Error, types.ErrOracleDisabled.Error())
+}
@@ -52,7 +52,12 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 		return types.ErrMaxValidatorsLowerThanCurrent
 	}
 
-	return k.Params.Set(ctx, params)
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	// the votes of the removed bridge executors are no longer counted
+	return k.pruneBridgeExecutorVotes(ctx)
 }
 
 // GetParams sets the x/opchild module parameters.
@@ -60,11 +65,8 @@ func (k Keeper) GetParams(ctx context.Context) (params types.Params, err error)
 	return k.Params.Get(ctx)
 }
 
+// MinGasPrices returns the base gas prices of the fee market, which are params.MinGasPrices
+// when the fee market is disabled.
 func (k Keeper) MinGasPrices(ctx context.Context) (sdk.DecCoins, error) {
-	params, err := k.GetParams(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return params.MinGasPrices, nil
+	return k.BaseGasPrices(ctx)
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -58,3 +59,30 @@ func Test_Change_MaxValidators(t *testing.T) {
 	err = input.OPChildKeeper.SetParams(ctx, params)
 	require.Error(t, err)
 }
+
+func Test_Params_LaneConfigs(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	params.LaneConfigs = []types.LaneConfig{
+		{Name: types.MEVLaneName, MaxBlockSpace: math.LegacyZeroDec(), Disabled: true},
+		{Name: types.FreeLaneName, MaxBlockSpace: math.LegacyNewDecWithPrec(1, 1), Disabled: true},
+		{Name: types.SystemLaneName, MaxBlockSpace: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	// the system and the default lanes cannot be disabled
+	for _, name := range []string{types.SystemLaneName, types.DefaultLaneName} {
+		params.LaneConfigs = []types.LaneConfig{{Name: name, MaxBlockSpace: math.LegacyZeroDec(), Disabled: true}}
+		require.ErrorIs(t, input.OPChildKeeper.SetParams(ctx, params), types.ErrInvalidLaneConfig, name)
+	}
+
+	// duplicate lane config
+	params.LaneConfigs = []types.LaneConfig{
+		{Name: types.FreeLaneName, MaxBlockSpace: math.LegacyZeroDec()},
+		{Name: types.FreeLaneName, MaxBlockSpace: math.LegacyZeroDec()},
+	}
+	require.ErrorIs(t, input.OPChildKeeper.SetParams(ctx, params), types.ErrInvalidLaneConfig)
+}
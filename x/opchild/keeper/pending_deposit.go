@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// bufferPendingDeposit stores the deposit ahead of the next l1 sequence, so it can be applied
+// once the previous deposits are finalized. Only the deposits within the pending deposit window
+// are buffered.
+func (ms MsgServer) bufferPendingDeposit(
+	ctx context.Context,
+	req *types.MsgFinalizeTokenDeposit,
+	nextL1Sequence uint64,
+) (types.ResponseResultType, error) {
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return types.UNSPECIFIED, err
+	} else if params.MaxPendingDeposits == 0 {
+		return types.UNSPECIFIED, types.ErrInvalidSequence
+	} else if req.Sequence > nextL1Sequence+params.MaxPendingDeposits {
+		return types.UNSPECIFIED, errorsmod.Wrapf(
+			types.ErrInvalidSequence,
+			"sequence %d is out of the pending deposit window; next l1 sequence %d, max pending deposits %d",
+			req.Sequence, nextL1Sequence, params.MaxPendingDeposits,
+		)
+	}
+
+	// no op if the deposit is already buffered
+	if found, err := ms.PendingDeposits.Has(ctx, req.Sequence); err != nil {
+		return types.UNSPECIFIED, err
+	} else if found {
+		return types.NOOP, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.PendingDeposits.Set(ctx, req.Sequence, types.PendingDeposit{
+		Sender:         req.Sender,
+		From:           req.From,
+		To:             req.To,
+		Amount:         req.Amount,
+		Sequence:       req.Sequence,
+		Height:         req.Height,
+		BaseDenom:      req.BaseDenom,
+		Data:           req.Data,
+		ReceivedHeight: uint64(sdkCtx.BlockHeight()), //nolint:gosec
+	}); err != nil {
+		return types.UNSPECIFIED, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePendingTokenDeposit,
+		sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(req.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, req.From),
+		sdk.NewAttribute(types.AttributeKeyRecipient, req.To),
+		sdk.NewAttribute(types.AttributeKeyDenom, req.Amount.Denom),
+		sdk.NewAttribute(types.AttributeKeyAmount, req.Amount.Amount.String()),
+	))
+
+	return types.PENDING, nil
+}
+
+// applyPendingDeposits finalizes the buffered deposits in order, as long as the deposit of
+// the next l1 sequence is buffered.
+func (ms MsgServer) applyPendingDeposits(ctx context.Context) error {
+	for {
+		nextL1Sequence, err := ms.GetNextL1Sequence(ctx)
+		if err != nil {
+			return err
+		}
+
+		deposit, err := ms.PendingDeposits.Get(ctx, nextL1Sequence)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := ms.PendingDeposits.Remove(ctx, nextL1Sequence); err != nil {
+			return err
+		}
+
+		if err := ms.finalizeTokenDeposit(ctx, deposit.ToMsg()); err != nil {
+			return err
+		}
+	}
+}
+
+// EvictPendingDeposits removes the pending deposits buffered longer than the pending deposit
+// timeout. The evicted deposits can be submitted again by the bridge executor.
+func (k Keeper) EvictPendingDeposits(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	} else if params.PendingDepositTimeout == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight()) //nolint:gosec
+
+	// collect the stale deposits first to avoid modifying the map while iterating
+	var staleDeposits []types.PendingDeposit
+	err = k.PendingDeposits.Walk(ctx, nil, func(_ uint64, deposit types.PendingDeposit) (stop bool, err error) {
+		if deposit.ReceivedHeight+params.PendingDepositTimeout <= height {
+			staleDeposits = append(staleDeposits, deposit)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, deposit := range staleDeposits {
+		if err := k.PendingDeposits.Remove(ctx, deposit.Sequence); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeEvictPendingDeposit,
+			sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(deposit.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(deposit.ReceivedHeight, 10)),
+		))
+	}
+
+	return nil
+}
+
+// GetPendingDeposits returns all the pending deposits in the order of the l1 sequence.
+func (k Keeper) GetPendingDeposits(ctx context.Context) ([]types.PendingDeposit, error) {
+	deposits := []types.PendingDeposit{}
+	err := k.PendingDeposits.Walk(ctx, nil, func(_ uint64, deposit types.PendingDeposit) (stop bool, err error) {
+		deposits = append(deposits, deposit)
+		return false, nil
+	})
+
+	return deposits, err
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_MsgServer_Deposit_OutOfOrder(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	newDeposit := func(sequence uint64) *types.MsgFinalizeTokenDeposit {
+		return types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], testutil.AddrsStr[1], sdk.NewCoin(denom, math.NewInt(100)), sequence, 1, "test_token", nil)
+	}
+
+	// buffer sequence 3 and 2
+	res, err := ms.FinalizeTokenDeposit(ctx, newDeposit(3))
+	require.NoError(t, err)
+	require.Equal(t, types.PENDING, res.Result)
+
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(2))
+	require.NoError(t, err)
+	require.Equal(t, types.PENDING, res.Result)
+
+	// duplicate pending deposit is no op
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(2))
+	require.NoError(t, err)
+	require.Equal(t, types.NOOP, res.Result)
+
+	require.Equal(t, math.ZeroInt(), input.BankKeeper.GetBalance(ctx, testutil.Addrs[1], denom).Amount)
+
+	// sequence 1 applies the buffered deposits
+	res, err = ms.FinalizeTokenDeposit(ctx, newDeposit(1))
+	require.NoError(t, err)
+	require.Equal(t, types.SUCCESS, res.Result)
+
+	require.Equal(t, math.NewInt(300), input.BankKeeper.GetBalance(ctx, testutil.Addrs[1], denom).Amount)
+
+	nextL1Sequence, err := input.OPChildKeeper.GetNextL1Sequence(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), nextL1Sequence)
+
+	pendingDeposits, err := input.OPChildKeeper.GetPendingDeposits(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingDeposits)
+}
+
+func Test_MsgServer_Deposit_PendingWindow(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxPendingDeposits = 2
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	newDeposit := func(sequence uint64) *types.MsgFinalizeTokenDeposit {
+		return types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], testutil.AddrsStr[1], sdk.NewCoin(denom, math.NewInt(100)), sequence, 1, "test_token", nil)
+	}
+
+	// within the window
+	_, err = ms.FinalizeTokenDeposit(ctx, newDeposit(3))
+	require.NoError(t, err)
+
+	// out of the window
+	_, err = ms.FinalizeTokenDeposit(ctx, newDeposit(4))
+	require.ErrorIs(t, err, types.ErrInvalidSequence)
+
+	// disable the pending deposits
+	params.MaxPendingDeposits = 0
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	_, err = ms.FinalizeTokenDeposit(ctx, newDeposit(2))
+	require.ErrorIs(t, err, types.ErrInvalidSequence)
+}
+
+func Test_EvictPendingDeposits(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.PendingDepositTimeout = 10
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	newDeposit := func(sequence uint64) *types.MsgFinalizeTokenDeposit {
+		return types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], testutil.AddrsStr[1], sdk.NewCoin(denom, math.NewInt(100)), sequence, 1, "test_token", nil)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(100)
+	_, err = ms.FinalizeTokenDeposit(sdkCtx, newDeposit(2))
+	require.NoError(t, err)
+
+	sdkCtx = sdkCtx.WithBlockHeight(105)
+	_, err = ms.FinalizeTokenDeposit(sdkCtx, newDeposit(3))
+	require.NoError(t, err)
+
+	// evict sequence 2 only
+	sdkCtx = sdkCtx.WithBlockHeight(110)
+	err = input.OPChildKeeper.EvictPendingDeposits(sdkCtx)
+	require.NoError(t, err)
+
+	pendingDeposits, err := input.OPChildKeeper.GetPendingDeposits(sdkCtx)
+	require.NoError(t, err)
+	require.Len(t, pendingDeposits, 1)
+	require.Equal(t, uint64(3), pendingDeposits[0].Sequence)
+
+	// evicted deposit can be submitted again
+	res, err := ms.FinalizeTokenDeposit(sdkCtx, newDeposit(2))
+	require.NoError(t, err)
+	require.Equal(t, types.PENDING, res.Result)
+}
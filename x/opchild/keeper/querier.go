@@ -8,9 +8,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 type Querier struct {
@@ -128,3 +130,376 @@ func (q Querier) MigrationInfo(ctx context.Context, req *types.QueryMigrationInf
 	}
 	return &types.QueryMigrationInfoResponse{MigrationInfo: migrationInfo, IbcDenom: migrationIBCDenom}, nil
 }
+
+// MigrationStatus implements the Query/MigrationStatus RPC method
+func (q Querier) MigrationStatus(ctx context.Context, req *types.QueryMigrationStatusRequest) (*types.QueryMigrationStatusResponse, error) {
+	migrationInfo, err := q.GetMigrationInfo(ctx, req.Denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	baseDenom, err := q.GetBaseDenom(ctx, req.Denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	migrationIBCDenom, err := ibcDenom(migrationInfo, baseDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	migrationStatus, err := q.GetMigrationStatus(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var autoMigration *types.AutoMigration
+	if progress, err := q.AutoMigrations.Get(ctx, req.Denom); err == nil {
+		autoMigration = &progress
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMigrationStatusResponse{
+		MigrationStatus: migrationStatus,
+		IbcDenom:        migrationIBCDenom,
+		Remaining:       q.bankKeeper.GetSupply(ctx, req.Denom),
+		IbcSupply:       q.bankKeeper.GetSupply(ctx, migrationIBCDenom),
+		AutoMigration:   autoMigration,
+	}, nil
+}
+
+// PendingDeposits implements the Query/PendingDeposits RPC method
+func (q Querier) PendingDeposits(ctx context.Context, req *types.QueryPendingDepositsRequest) (*types.QueryPendingDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pendingDeposits, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.PendingDeposits, req.Pagination, func(_ uint64, deposit types.PendingDeposit) (types.PendingDeposit, error) {
+		return deposit, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingDepositsResponse{PendingDeposits: pendingDeposits, Pagination: pageRes}, nil
+}
+
+// DepositVotes implements the Query/DepositVotes RPC method
+func (q Querier) DepositVotes(ctx context.Context, req *types.QueryDepositVotesRequest) (*types.QueryDepositVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	votes, err := q.GetDepositVotes(ctx, req.Sequence)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositVotesResponse{Votes: votes, Threshold: params.BridgeExecutorThreshold}, nil
+}
+
+// BridgeInfoVotes implements the Query/BridgeInfoVotes RPC method
+func (q Querier) BridgeInfoVotes(ctx context.Context, req *types.QueryBridgeInfoVotesRequest) (*types.QueryBridgeInfoVotesResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	votes, err := q.GetBridgeInfoVotes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBridgeInfoVotesResponse{Votes: votes, Threshold: params.BridgeExecutorThreshold}, nil
+}
+
+// ScheduledExecutorChanges implements the Query/ScheduledExecutorChanges RPC method
+func (q Querier) ScheduledExecutorChanges(ctx context.Context, req *types.QueryScheduledExecutorChangesRequest) (*types.QueryScheduledExecutorChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	plans, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.ExecutorChanges, req.Pagination, func(_ uint64, plan types.ExecutorChangePlan) (types.ExecutorChangePlan, error) {
+		return plan, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledExecutorChangesResponse{Plans: plans, Pagination: pageRes}, nil
+}
+
+// Sequencers implements the Query/Sequencers RPC method
+func (q Querier) Sequencers(ctx context.Context, req *types.QuerySequencersRequest) (*types.QuerySequencersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sequencers, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Sequencers, req.Pagination, func(_ []byte, sequencer types.Sequencer) (types.Sequencer, error) {
+		return sequencer, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySequencersResponse{Sequencers: sequencers, Pagination: pageRes}, nil
+}
+
+// ForcedTxs implements the Query/ForcedTxs RPC method
+func (q Querier) ForcedTxs(ctx context.Context, req *types.QueryForcedTxsRequest) (*types.QueryForcedTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	forcedTxs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.ForcedTxs, req.Pagination, func(_ uint64, forcedTx ophosttypes.ForcedTx) (ophosttypes.ForcedTx, error) {
+		return forcedTx, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextSequence, err := q.GetNextForcedTxSequence(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryForcedTxsResponse{ForcedTxs: forcedTxs, NextForcedTxSequence: nextSequence, Pagination: pageRes}, nil
+}
+
+func (q Querier) WithdrawalRoot(ctx context.Context, req *types.QueryWithdrawalRootRequest) (*types.QueryWithdrawalRootResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	root, start, end, err := q.GetWithdrawalRoot(ctx, req.StartSequence, req.EndSequence)
+	if err != nil {
+		return nil, withdrawalTreeQueryError(err)
+	}
+
+	return &types.QueryWithdrawalRootResponse{Root: root, StartSequence: start, EndSequence: end}, nil
+}
+
+func (q Querier) WithdrawalProof(ctx context.Context, req *types.QueryWithdrawalProofRequest) (*types.QueryWithdrawalProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := q.GetWithdrawalProof(ctx, req.Sequence, req.StartSequence, req.EndSequence)
+	if err != nil {
+		return nil, withdrawalTreeQueryError(err)
+	}
+
+	return &res, nil
+}
+
+func (q Querier) FinalizeTokenWithdrawalMsg(ctx context.Context, req *types.QueryFinalizeTokenWithdrawalMsgRequest) (*types.QueryFinalizeTokenWithdrawalMsgResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	msg, start, end, err := q.GetFinalizeTokenWithdrawalMsg(ctx, req.Sequence, req.OutputIndex, req.StartBlockNumber, req.EndBlockNumber, req.Sender)
+	if err != nil {
+		return nil, withdrawalTreeQueryError(err)
+	}
+
+	return &types.QueryFinalizeTokenWithdrawalMsgResponse{Msg: *msg, StartSequence: start, EndSequence: end}, nil
+}
+
+func (q Querier) Withdrawal(ctx context.Context, req *types.QueryWithdrawalRequest) (*types.QueryWithdrawalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := q.GetWithdrawalRecord(ctx, req.Sequence)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "withdrawal %d not found", req.Sequence)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawalResponse{Withdrawal: record}, nil
+}
+
+func (q Querier) WithdrawalsBySender(ctx context.Context, req *types.QueryWithdrawalsBySenderRequest) (*types.QueryWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := q.authKeeper.AddressCodec().StringToBytes(req.Sender); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return q.paginateWithdrawals(ctx, q.SenderWithdrawals, req.Sender, req.Pagination)
+}
+
+func (q Querier) WithdrawalsByReceiver(ctx context.Context, req *types.QueryWithdrawalsByReceiverRequest) (*types.QueryWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Receiver == "" {
+		return nil, status.Error(codes.InvalidArgument, "receiver cannot be empty")
+	}
+
+	return q.paginateWithdrawals(ctx, q.ReceiverWithdrawals, req.Receiver, req.Pagination)
+}
+
+func (q Querier) paginateWithdrawals(
+	ctx context.Context,
+	index collections.KeySet[collections.Pair[string, uint64]],
+	addr string,
+	pagination *query.PageRequest,
+) (*types.QueryWithdrawalsResponse, error) {
+	withdrawals, pageRes, err := query.CollectionPaginate(ctx, index, pagination, func(key collections.Pair[string, uint64], _ collections.NoValue) (types.WithdrawalRecord, error) {
+		return q.GetWithdrawalRecord(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[string, uint64](addr))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawalsResponse{Withdrawals: withdrawals, Pagination: pageRes}, nil
+}
+
+func (q Querier) Deposit(ctx context.Context, req *types.QueryDepositRequest) (*types.QueryDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := q.GetDepositRecord(ctx, req.Sequence)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "deposit %d not found", req.Sequence)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositResponse{Deposit: record}, nil
+}
+
+func (q Querier) FailedDeposits(ctx context.Context, req *types.QueryFailedDepositsRequest) (*types.QueryFailedDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	deposits, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.FailedDeposits, req.Pagination, func(l1Sequence uint64, _ collections.NoValue) (types.DepositRecord, error) {
+		return q.GetDepositRecord(ctx, l1Sequence)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFailedDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
+func withdrawalTreeQueryError(err error) error {
+	switch {
+	case errors.Is(err, types.ErrInvalidSequence), errors.Is(err, types.ErrInvalidBlockHeight):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, types.ErrWithdrawalNotFound), errors.Is(err, types.ErrBlockHashNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (q Querier) GasPrice(ctx context.Context, req *types.QueryGasPriceRequest) (*types.QueryGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	gasPrices, err := q.BaseGasPrices(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if req.Denom != "" {
+		var filtered sdk.DecCoins
+		for _, gasPrice := range gasPrices {
+			if gasPrice.Denom == req.Denom {
+				filtered = append(filtered, gasPrice)
+			}
+		}
+		if len(filtered) == 0 {
+			return nil, status.Errorf(codes.NotFound, "gas price of %s not found", req.Denom)
+		}
+
+		gasPrices = filtered
+	}
+
+	multiplier, err := q.GetBaseFeeMultiplier(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGasPriceResponse{
+		GasPrices:         gasPrices,
+		BaseFeeMultiplier: multiplier,
+	}, nil
+}
+
+func (q Querier) Sponsorship(ctx context.Context, req *types.QuerySponsorshipRequest) (*types.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sponsorship, err := q.GetSponsorship(ctx, req.Account, req.MsgTypeUrl)
+	if err != nil && errors.Is(err, types.ErrSponsorshipNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySponsorshipResponse{Sponsorship: sponsorship, Remaining: sponsorship.Remaining()}, nil
+}
+
+func (q Querier) Sponsorships(ctx context.Context, req *types.QuerySponsorshipsRequest) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sponsorships, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Sponsorships, req.Pagination, func(key collections.Pair[string, string], _ types.Sponsorship) (types.Sponsorship, error) {
+		return q.GetSponsorship(ctx, key.K1(), key.K2())
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySponsorshipsResponse{Sponsorships: sponsorships, Pagination: pageRes}, nil
+}
+
+func (q Querier) FeeDistributionTotal(ctx context.Context, req *types.QueryFeeDistributionTotalRequest) (*types.QueryFeeDistributionTotalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	total, err := q.GetFeeDistributionTotal(ctx, req.Recipient)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeDistributionTotalResponse{Total: total}, nil
+}
+
+func (q Querier) FeeDistributionTotals(ctx context.Context, req *types.QueryFeeDistributionTotalsRequest) (*types.QueryFeeDistributionTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	totals, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.FeeDistributions, req.Pagination, func(_ string, total types.FeeDistributionTotal) (types.FeeDistributionTotal, error) {
+		return total, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeDistributionTotalsResponse{Totals: totals, Pagination: pageRes}, nil
+}
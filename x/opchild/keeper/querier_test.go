@@ -178,3 +178,19 @@ func Test_QueryMigrationInfo(t *testing.T) {
 		IbcPortId:    port,
 	}, IbcDenom: ibcDenom}, *res)
 }
+
+func Test_QueryPendingDeposits(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	msg := types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], testutil.AddrsStr[1], testutil.AddrsStr[1], sdk.NewInt64Coin(denom, 100), 2, 1, "test_token", nil)
+	_, err := ms.FinalizeTokenDeposit(ctx, msg)
+	require.NoError(t, err)
+
+	q := keeper.NewQuerier(&input.OPChildKeeper)
+	res, err := q.PendingDeposits(ctx, &types.QueryPendingDepositsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.PendingDeposits, 1)
+	require.Equal(t, msg, res.PendingDeposits[0].ToMsg())
+}
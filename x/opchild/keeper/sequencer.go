@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// GetSequencers returns the registered sequencers in the order of the operator address.
+func (k Keeper) GetSequencers(ctx context.Context) ([]types.Sequencer, error) {
+	sequencers := []types.Sequencer{}
+	err := k.Sequencers.Walk(ctx, nil, func(_ []byte, sequencer types.Sequencer) (stop bool, err error) {
+		sequencers = append(sequencers, sequencer)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sequencers, nil
+}
+
+// AddSequencer registers a sequencer to the sequencer set. The sequencer is included in
+// the validator set at the end of the block if there is an active slot for it.
+func (k Keeper) AddSequencer(ctx context.Context, sequencer types.Sequencer) error {
+	if err := sequencer.Validate(k.validatorAddressCodec); err != nil {
+		return err
+	}
+
+	if err := k.adoptLegacySequencers(ctx); err != nil {
+		return err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(sequencer.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if found, err := k.Sequencers.Has(ctx, valAddr); err != nil {
+		return err
+	} else if found {
+		return errorsmod.Wrap(types.ErrSequencerExists, sequencer.OperatorAddress)
+	}
+
+	// the sequencer cannot share the operator or the consensus key with the other validators
+	if _, found := k.GetValidator(ctx, valAddr); found {
+		return types.ErrValidatorOwnerExists
+	}
+
+	pubkey, err := sequencer.ConsPubKey()
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pubkey)); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	sequencers, err := k.GetSequencers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, seq := range sequencers {
+		pk, err := seq.ConsPubKey()
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(pk.Bytes(), pubkey.Bytes()) {
+			return types.ErrValidatorPubKeyExists
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cp := sdkCtx.ConsensusParams()
+	if !slices.Contains(cp.Validator.PubKeyTypes, pubkey.Type()) {
+		return errorsmod.Wrapf(
+			types.ErrValidatorPubKeyTypeNotSupported,
+			"got: %s, expected: %s", pubkey.Type(), cp.Validator.PubKeyTypes,
+		)
+	}
+
+	if err := k.Sequencers.Set(ctx, valAddr, sequencer); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddSequencer,
+			sdk.NewAttribute(types.AttributeKeyValidator, sequencer.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyWeight, strconv.FormatInt(sequencer.Weight, 10)),
+		),
+	)
+
+	return nil
+}
+
+// RemoveSequencer removes a sequencer from the sequencer set. If the sequencer is active,
+// it is removed from the validator set at the end of the block.
+func (k Keeper) RemoveSequencer(ctx context.Context, valAddr sdk.ValAddress) error {
+	if err := k.adoptLegacySequencers(ctx); err != nil {
+		return err
+	}
+
+	sequencer, err := k.Sequencers.Get(ctx, valAddr)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrSequencerNotFound, err.Error())
+	}
+
+	sequencers, err := k.GetSequencers(ctx)
+	if err != nil {
+		return err
+	} else if len(sequencers) == 1 {
+		return types.ErrEmptySequencerSet
+	}
+
+	if err := k.Sequencers.Remove(ctx, valAddr); err != nil {
+		return err
+	}
+
+	if validator, found := k.GetValidator(ctx, valAddr); found && validator.ConsPower > 0 {
+		if err := k.RemoveValidatorByAddress(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveSequencer,
+			sdk.NewAttribute(types.AttributeKeyValidator, sequencer.OperatorAddress),
+		),
+	)
+
+	return nil
+}
+
+// adoptLegacySequencers registers the validators with SequencerConsPower to the sequencer set
+// when the set is empty, which is the state of the chains running a single sequencer.
+func (k Keeper) adoptLegacySequencers(ctx context.Context) error {
+	if empty, err := k.Sequencers.IsEmpty(ctx); err != nil || !empty {
+		return err
+	}
+
+	validators, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		if validator.ConsPower != types.SequencerConsPower {
+			continue
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.OperatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Sequencers.Set(ctx, valAddr, types.Sequencer{
+			Moniker:         validator.Moniker,
+			OperatorAddress: validator.OperatorAddress,
+			ConsensusPubkey: validator.ConsensusPubkey,
+			Weight:          validator.ConsPower,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// activeSequencerSlots returns the number of sequencers which can be active at the same time.
+// The slots are bounded by the max validators minus the other validators, e.g. attestors,
+// and by the max sequencers if it is set.
+func (k Keeper) activeSequencerSlots(ctx context.Context, params types.Params) (int, error) {
+	others := 0
+	err := k.Validators.Walk(ctx, nil, func(key []byte, validator types.Validator) (stop bool, err error) {
+		if validator.ConsPower <= 0 {
+			return false, nil
+		}
+
+		if isSequencer, err := k.Sequencers.Has(ctx, key); err != nil {
+			return true, err
+		} else if !isSequencer {
+			others++
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	slots := int(params.MaxValidators) - others
+	if params.MaxSequencers > 0 && slots > int(params.MaxSequencers) {
+		slots = int(params.MaxSequencers)
+	}
+
+	return slots, nil
+}
+
+// RotateSequencers updates the validator set to include only the active sequencers.
+// When more sequencers are registered than the active slots, the active window moves
+// by the number of slots every sequencer rotation interval. Called in each EndBlock.
+func (k Keeper) RotateSequencers(ctx context.Context) error {
+	sequencers, err := k.GetSequencers(ctx)
+	if err != nil {
+		return err
+	} else if len(sequencers) == 0 {
+		// the chain is running a single sequencer without the sequencer set
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	slots, err := k.activeSequencerSlots(ctx, params)
+	if err != nil {
+		return err
+	} else if slots <= 0 {
+		// keep the current sequencers rather than halting the chain without a block producer
+		return nil
+	}
+
+	numActive := min(len(sequencers), slots)
+	offset := 0
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if len(sequencers) > slots && params.SequencerRotationInterval > 0 {
+		round := uint64(sdkCtx.BlockHeight()) / params.SequencerRotationInterval //nolint:gosec
+		offset = int((round * uint64(slots)) % uint64(len(sequencers)))          //nolint:gosec
+	}
+
+	changed := false
+	active := make([]string, 0, numActive)
+	for i, sequencer := range sequencers {
+		// the active window wraps around the end of the sequencers
+		isActive := (i-offset+len(sequencers))%len(sequencers) < numActive
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(sequencer.OperatorAddress)
+		if err != nil {
+			return err
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if isActive {
+			active = append(active, sequencer.OperatorAddress)
+			if found && validator.ConsPower == sequencer.Weight {
+				continue
+			}
+
+			if err := k.SetValidator(ctx, sequencer.ToValidator()); err != nil {
+				return err
+			}
+			if !found {
+				if err := k.SetValidatorByConsAddr(ctx, sequencer.ToValidator()); err != nil {
+					return err
+				}
+			}
+
+			changed = true
+		} else if found && validator.ConsPower > 0 {
+			if err := k.RemoveValidatorByAddress(ctx, valAddr); err != nil {
+				return err
+			}
+
+			changed = true
+		}
+	}
+
+	if changed {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRotateSequencers,
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeySequencers, strings.Join(active, ",")),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"slices"
+	"testing"
+
+	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+func Test_MsgServer_AddRemoveSequencer(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	valPubKeys := testutilsims.CreateTestPubKeys(4)
+
+	// the legacy single sequencer
+	seq, err := types.NewValidator(testutil.ValAddrs[0], valPubKeys[0], "sequencer0")
+	require.NoError(t, err)
+	require.NoError(t, input.OPChildKeeper.SetValidator(ctx, seq))
+	require.NoError(t, input.OPChildKeeper.SetValidatorByConsAddr(ctx, seq))
+
+	// an attestor
+	require.NoError(t, input.OPChildKeeper.AddValidatorWithPower(ctx, "attestor", testutil.ValAddrs[3], valPubKeys[3], types.AttestorConsPower))
+
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	// unauthorized
+	msg, err := types.NewMsgAddSequencer("sequencer1", testutil.AddrsStr[0], testutil.ValAddrsStr[1], valPubKeys[1], 2)
+	require.NoError(t, err)
+	_, err = ms.AddSequencer(ctx, msg)
+	require.Error(t, err)
+
+	// invalid weight
+	msg, err = types.NewMsgAddSequencer("sequencer1", moduleAddr, testutil.ValAddrsStr[1], valPubKeys[1], 0)
+	require.NoError(t, err)
+	_, err = ms.AddSequencer(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSequencerWeight)
+
+	msg, err = types.NewMsgAddSequencer("sequencer1", moduleAddr, testutil.ValAddrsStr[1], valPubKeys[1], 2)
+	require.NoError(t, err)
+	_, err = ms.AddSequencer(ctx, msg)
+	require.NoError(t, err)
+
+	// duplicate sequencer
+	_, err = ms.AddSequencer(ctx, msg)
+	require.ErrorIs(t, err, types.ErrSequencerExists)
+
+	// duplicate consensus key
+	msg, err = types.NewMsgAddSequencer("sequencer2", moduleAddr, testutil.ValAddrsStr[2], valPubKeys[1], 1)
+	require.NoError(t, err)
+	_, err = ms.AddSequencer(ctx, msg)
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+
+	// the attestor cannot be a sequencer
+	msg, err = types.NewMsgAddSequencer("attestor", moduleAddr, testutil.ValAddrsStr[3], valPubKeys[2], 1)
+	require.NoError(t, err)
+	_, err = ms.AddSequencer(ctx, msg)
+	require.ErrorIs(t, err, types.ErrValidatorOwnerExists)
+
+	// the legacy sequencer is adopted into the sequencer set
+	sequencers, err := input.OPChildKeeper.GetSequencers(ctx)
+	require.NoError(t, err)
+	require.Len(t, sequencers, 2)
+
+	// the new sequencer is activated at the end block
+	require.NoError(t, input.OPChildKeeper.RotateSequencers(ctx))
+	validator, found := input.OPChildKeeper.GetValidator(ctx, testutil.ValAddrs[1])
+	require.True(t, found)
+	require.Equal(t, int64(2), validator.ConsPower)
+
+	// remove the legacy sequencer
+	_, err = ms.RemoveSequencer(ctx, types.NewMsgRemoveSequencer(moduleAddr, testutil.ValAddrsStr[0]))
+	require.NoError(t, err)
+	validator, found = input.OPChildKeeper.GetValidator(ctx, testutil.ValAddrs[0])
+	require.True(t, found)
+	require.Zero(t, validator.ConsPower)
+
+	// not a sequencer
+	_, err = ms.RemoveSequencer(ctx, types.NewMsgRemoveSequencer(moduleAddr, testutil.ValAddrsStr[3]))
+	require.ErrorIs(t, err, types.ErrSequencerNotFound)
+
+	// the last sequencer cannot be removed
+	_, err = ms.RemoveSequencer(ctx, types.NewMsgRemoveSequencer(moduleAddr, testutil.ValAddrsStr[1]))
+	require.ErrorIs(t, err, types.ErrEmptySequencerSet)
+
+	// the attestor is not affected
+	validator, found = input.OPChildKeeper.GetValidator(ctx, testutil.ValAddrs[3])
+	require.True(t, found)
+	require.Equal(t, int64(types.AttestorConsPower), validator.ConsPower)
+}
+
+func Test_RotateSequencers(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxValidators = 3
+	params.MaxSequencers = 2
+	params.SequencerRotationInterval = 10
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	valPubKeys := testutilsims.CreateTestPubKeys(5)
+	for i := 0; i < 4; i++ {
+		sequencer, err := types.NewSequencer(testutil.ValAddrs[i], valPubKeys[i], "sequencer", int64(i+1))
+		require.NoError(t, err)
+		require.NoError(t, input.OPChildKeeper.AddSequencer(ctx, sequencer))
+	}
+
+	sequencers, err := input.OPChildKeeper.GetSequencers(ctx)
+	require.NoError(t, err)
+	require.Len(t, sequencers, 4)
+
+	requireActive := func(ctx sdk.Context, active ...int) {
+		require.NoError(t, input.OPChildKeeper.RotateSequencers(ctx))
+		_, err := input.OPChildKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		require.NoError(t, err)
+
+		for i, sequencer := range sequencers {
+			valAddr, err := sdk.ValAddressFromBech32(sequencer.OperatorAddress)
+			require.NoError(t, err)
+
+			validator, found := input.OPChildKeeper.GetValidator(ctx, valAddr)
+			if slices.Contains(active, i) {
+				require.True(t, found)
+				require.Equal(t, sequencer.Weight, validator.ConsPower)
+			} else {
+				require.False(t, found)
+			}
+		}
+	}
+
+	// the first window is active until the rotation interval
+	requireActive(ctx.WithBlockHeight(1), 0, 1)
+	requireActive(ctx.WithBlockHeight(9), 0, 1)
+
+	// rotate to the next window
+	requireActive(ctx.WithBlockHeight(10), 2, 3)
+
+	// wrap around the sequencers
+	requireActive(ctx.WithBlockHeight(20), 0, 1)
+
+	// without the max sequencers, the slots are bounded by the max validators
+	params.MaxSequencers = 0
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+	requireActive(ctx.WithBlockHeight(10), 3, 0, 1)
+
+	// the attestor takes one of the slots
+	attestor, err := types.NewValidator(testutil.ValAddrs[4], valPubKeys[4], "attestor")
+	require.NoError(t, err)
+	attestor.ConsPower = types.AttestorConsPower
+	require.NoError(t, input.OPChildKeeper.SetValidator(ctx, attestor))
+	require.NoError(t, input.OPChildKeeper.SetValidatorByConsAddr(ctx, attestor))
+	requireActive(ctx.WithBlockHeight(10), 2, 3)
+}
@@ -200,5 +200,7 @@ func (k *Keeper) Shutdown(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return nil
+
+	// report the finished shutdown to L1 at this block regardless of the report interval
+	return k.LastStatusReport.Remove(ctx)
 }
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// RegisterSponsorship registers or replaces the sponsorship of the account and the message type.
+// The gas used in the current period is kept when the sponsorship is replaced.
+func (k Keeper) RegisterSponsorship(ctx context.Context, account, msgTypeURL string, gasBudget, period, accountGasLimit uint64) error {
+	sponsorship, err := k.GetSponsorship(ctx, account, msgTypeURL)
+	if err != nil && !errors.Is(err, types.ErrSponsorshipNotFound) {
+		return err
+	} else if err != nil {
+		sponsorship = types.Sponsorship{
+			Account:     account,
+			MsgTypeUrl:  msgTypeURL,
+			PeriodStart: uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()), //nolint:gosec
+		}
+	}
+
+	sponsorship.GasBudget = gasBudget
+	sponsorship.Period = period
+	sponsorship.AccountGasLimit = accountGasLimit
+
+	return k.Sponsorships.Set(ctx, collections.Join(account, msgTypeURL), sponsorship)
+}
+
+// GetSponsorship returns the sponsorship of the account and the message type, with the budget
+// refreshed if the period has passed.
+func (k Keeper) GetSponsorship(ctx context.Context, account, msgTypeURL string) (types.Sponsorship, error) {
+	sponsorship, err := k.Sponsorships.Get(ctx, collections.Join(account, msgTypeURL))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.Sponsorship{}, types.ErrSponsorshipNotFound.Wrapf("account %s, msg type url %s", account, msgTypeURL)
+	} else if err != nil {
+		return types.Sponsorship{}, err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if sponsorship.Period != 0 && height >= sponsorship.PeriodStart+sponsorship.Period {
+		sponsorship.GasUsed = 0
+		sponsorship.PeriodStart = height
+	}
+
+	return sponsorship, nil
+}
+
+// FindSponsorship returns the sponsorship which can pay the gas limit of the tx. The sponsorship
+// of the fee payer and the message type is preferred, then the one of the fee payer, and then the
+// one of the message type. The message type is only considered when all the messages of the tx
+// are of the same type, and only up to its account gas limit for the fee payer.
+func (k Keeper) FindSponsorship(ctx context.Context, feePayer []byte, msgs []sdk.Msg, gas uint64) (types.Sponsorship, bool, error) {
+	if len(msgs) == 0 {
+		return types.Sponsorship{}, false, nil
+	}
+
+	account, err := k.authKeeper.AddressCodec().BytesToString(feePayer)
+	if err != nil {
+		return types.Sponsorship{}, false, err
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(msgs[0])
+	for _, msg := range msgs[1:] {
+		if sdk.MsgTypeURL(msg) != msgTypeURL {
+			msgTypeURL = ""
+			break
+		}
+	}
+
+	candidates := [][2]string{{account, ""}}
+	if msgTypeURL != "" {
+		candidates = [][2]string{{account, msgTypeURL}, {account, ""}, {"", msgTypeURL}}
+	}
+
+	for _, candidate := range candidates {
+		sponsorship, err := k.GetSponsorship(ctx, candidate[0], candidate[1])
+		if err != nil && errors.Is(err, types.ErrSponsorshipNotFound) {
+			continue
+		} else if err != nil {
+			return types.Sponsorship{}, false, err
+		}
+
+		if sponsorship.Remaining() < gas {
+			continue
+		}
+
+		// the sponsorship of any fee payer is bounded per fee payer
+		if sponsorship.Account == "" {
+			accountGasUsed, err := k.getSponsorshipUsage(ctx, sponsorship, account)
+			if err != nil {
+				return types.Sponsorship{}, false, err
+			} else if sponsorship.AccountRemaining(accountGasUsed) < gas {
+				continue
+			}
+		}
+
+		return sponsorship, true, nil
+	}
+
+	return types.Sponsorship{}, false, nil
+}
+
+// getSponsorshipUsage returns the gas used by the fee payer from the sponsorship of any fee payer
+// in the current period.
+func (k Keeper) getSponsorshipUsage(ctx context.Context, sponsorship types.Sponsorship, feePayer string) (uint64, error) {
+	gasUsed, err := k.SponsorshipUsages.Get(ctx, collections.Join3(sponsorship.MsgTypeUrl, sponsorship.PeriodStart, feePayer))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+
+	return gasUsed, err
+}
+
+// addSponsorshipUsage adds the gas used by the fee payer from the sponsorship of any fee payer,
+// and removes the usages of the previous periods.
+func (k Keeper) addSponsorshipUsage(ctx context.Context, sponsorship types.Sponsorship, feePayer string, gas uint64) error {
+	var staleKeys []collections.Triple[string, uint64, string]
+	err := k.SponsorshipUsages.Walk(ctx, collections.NewPrefixedTripleRange[string, uint64, string](sponsorship.MsgTypeUrl), func(key collections.Triple[string, uint64, string], _ uint64) (stop bool, err error) {
+		if key.K2() >= sponsorship.PeriodStart {
+			return true, nil
+		}
+
+		staleKeys = append(staleKeys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range staleKeys {
+		if err := k.SponsorshipUsages.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	gasUsed, err := k.getSponsorshipUsage(ctx, sponsorship, feePayer)
+	if err != nil {
+		return err
+	}
+
+	return k.SponsorshipUsages.Set(ctx, collections.Join3(sponsorship.MsgTypeUrl, sponsorship.PeriodStart, feePayer), gasUsed+gas)
+}
+
+// ConsumeSponsorship charges the gas limit of the tx to the sponsorship found by FindSponsorship.
+// It returns false if no sponsorship can pay the gas limit.
+func (k Keeper) ConsumeSponsorship(ctx context.Context, feePayer []byte, msgs []sdk.Msg, gas uint64) (bool, error) {
+	sponsorship, found, err := k.FindSponsorship(ctx, feePayer, msgs, gas)
+	if err != nil || !found {
+		return false, err
+	}
+
+	account, err := k.authKeeper.AddressCodec().BytesToString(feePayer)
+	if err != nil {
+		return false, err
+	}
+
+	sponsorship.GasUsed += gas
+	if err := k.Sponsorships.Set(ctx, collections.Join(sponsorship.Account, sponsorship.MsgTypeUrl), sponsorship); err != nil {
+		return false, err
+	} else if sponsorship.Account == "" {
+		if err := k.addSponsorshipUsage(ctx, sponsorship, account, gas); err != nil {
+			return false, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConsumeSponsorship,
+		sdk.NewAttribute(types.AttributeKeyAccount, sponsorship.Account),
+		sdk.NewAttribute(types.AttributeKeyMsgType, sponsorship.MsgTypeUrl),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, account),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gas, 10)),
+	))
+
+	return true, nil
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+func Test_MsgServer_Sponsorship(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	q := keeper.NewQuerier(&input.OPChildKeeper)
+
+	govAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	// either account or msg type url is required
+	_, err := ms.RegisterSponsorship(ctx, types.NewMsgRegisterSponsorship(govAddr, "", "", 100, 10, 0))
+	require.ErrorIs(t, err, types.ErrInvalidSponsorship)
+
+	// zero gas budget
+	_, err = ms.RegisterSponsorship(ctx, types.NewMsgRegisterSponsorship(govAddr, testutil.AddrsStr[1], "", 0, 10, 0))
+	require.ErrorIs(t, err, types.ErrInvalidSponsorship)
+
+	// unauthorized
+	_, err = ms.RegisterSponsorship(ctx, types.NewMsgRegisterSponsorship(testutil.AddrsStr[1], testutil.AddrsStr[1], "", 100, 10, 0))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// the account gas limit is required only for the sponsorship of any fee payer
+	_, err = ms.RegisterSponsorship(ctx, types.NewMsgRegisterSponsorship(govAddr, "", msgTypeURL, 200, 0, 0))
+	require.ErrorIs(t, err, types.ErrInvalidSponsorship)
+	_, err = ms.RegisterSponsorship(ctx, types.NewMsgRegisterSponsorship(govAddr, "", msgTypeURL, 200, 0, 300))
+	require.ErrorIs(t, err, types.ErrInvalidSponsorship)
+	_, err = ms.RegisterSponsorship(ctx, types.NewMsgRegisterSponsorship(govAddr, testutil.AddrsStr[1], "", 100, 10, 50))
+	require.ErrorIs(t, err, types.ErrInvalidSponsorship)
+
+	// register by gov and admin
+	_, err = ms.RegisterSponsorship(ctx, types.NewMsgRegisterSponsorship(govAddr, testutil.AddrsStr[1], "", 100, 10, 0))
+	require.NoError(t, err)
+	_, err = ms.RegisterSponsorship(ctx, types.NewMsgRegisterSponsorship(testutil.AddrsStr[0], "", msgTypeURL, 200, 0, 50))
+	require.NoError(t, err)
+
+	res, err := q.Sponsorship(ctx, &types.QuerySponsorshipRequest{Account: testutil.AddrsStr[1]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), res.Sponsorship.GasBudget)
+	require.Equal(t, uint64(100), res.Remaining)
+
+	resAll, err := q.Sponsorships(ctx, &types.QuerySponsorshipsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resAll.Sponsorships, 2)
+
+	// remove
+	_, err = ms.RemoveSponsorship(ctx, types.NewMsgRemoveSponsorship(govAddr, "", msgTypeURL))
+	require.NoError(t, err)
+	_, err = ms.RemoveSponsorship(ctx, types.NewMsgRemoveSponsorship(govAddr, "", msgTypeURL))
+	require.ErrorIs(t, err, types.ErrSponsorshipNotFound)
+
+	_, err = q.Sponsorship(ctx, &types.QuerySponsorshipRequest{MsgTypeUrl: msgTypeURL})
+	require.Error(t, err)
+}
+
+func Test_ConsumeSponsorship(t *testing.T) {
+	goCtx, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(1)
+	q := keeper.NewQuerier(&input.OPChildKeeper)
+
+	sendMsg := &banktypes.MsgSend{FromAddress: testutil.AddrsStr[1], ToAddress: testutil.AddrsStr[2]}
+	multiSendMsg := &banktypes.MsgMultiSend{}
+	sendTypeURL := sdk.MsgTypeURL(sendMsg)
+
+	// nothing is sponsored
+	sponsored, err := input.OPChildKeeper.ConsumeSponsorship(ctx, testutil.Addrs[1], []sdk.Msg{sendMsg}, 10)
+	require.NoError(t, err)
+	require.False(t, sponsored)
+
+	require.NoError(t, input.OPChildKeeper.RegisterSponsorship(ctx, testutil.AddrsStr[1], sendTypeURL, 30, 10, 0))
+	require.NoError(t, input.OPChildKeeper.RegisterSponsorship(ctx, testutil.AddrsStr[1], "", 50, 0, 0))
+	require.NoError(t, input.OPChildKeeper.RegisterSponsorship(ctx, "", sendTypeURL, 100, 10, 30))
+
+	// the sponsorship of the account and the msg type is consumed first
+	sponsored, err = input.OPChildKeeper.ConsumeSponsorship(ctx, testutil.Addrs[1], []sdk.Msg{sendMsg}, 20)
+	require.NoError(t, err)
+	require.True(t, sponsored)
+
+	res, err := q.Sponsorship(ctx, &types.QuerySponsorshipRequest{Account: testutil.AddrsStr[1], MsgTypeUrl: sendTypeURL})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), res.Remaining)
+
+	// falls back to the sponsorship of the account
+	sponsored, err = input.OPChildKeeper.ConsumeSponsorship(ctx, testutil.Addrs[1], []sdk.Msg{sendMsg}, 20)
+	require.NoError(t, err)
+	require.True(t, sponsored)
+
+	res, err = q.Sponsorship(ctx, &types.QuerySponsorshipRequest{Account: testutil.AddrsStr[1]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), res.Remaining)
+
+	// the msg type sponsorship is only used when all the msgs are of the same type
+	sponsored, err = input.OPChildKeeper.ConsumeSponsorship(ctx, testutil.Addrs[2], []sdk.Msg{sendMsg, multiSendMsg}, 20)
+	require.NoError(t, err)
+	require.False(t, sponsored)
+
+	sponsored, err = input.OPChildKeeper.ConsumeSponsorship(ctx, testutil.Addrs[2], []sdk.Msg{sendMsg, sendMsg}, 20)
+	require.NoError(t, err)
+	require.True(t, sponsored)
+
+	// a fee payer cannot use more than the account gas limit of the msg type sponsorship
+	sponsored, err = input.OPChildKeeper.ConsumeSponsorship(ctx, testutil.Addrs[2], []sdk.Msg{sendMsg}, 20)
+	require.NoError(t, err)
+	require.False(t, sponsored)
+
+	sponsored, err = input.OPChildKeeper.ConsumeSponsorship(ctx, testutil.Addrs[3], []sdk.Msg{sendMsg}, 20)
+	require.NoError(t, err)
+	require.True(t, sponsored)
+
+	res, err = q.Sponsorship(ctx, &types.QuerySponsorshipRequest{MsgTypeUrl: sendTypeURL})
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), res.Remaining)
+
+	// the account gas limit is refreshed with the period, and the stale usages are removed
+	nextCtx := ctx.WithBlockHeight(11)
+	sponsored, err = input.OPChildKeeper.ConsumeSponsorship(nextCtx, testutil.Addrs[2], []sdk.Msg{sendMsg}, 20)
+	require.NoError(t, err)
+	require.True(t, sponsored)
+
+	found, err := input.OPChildKeeper.SponsorshipUsages.Has(nextCtx, collections.Join3(sendTypeURL, uint64(1), testutil.AddrsStr[3]))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// removing the sponsorship removes the usages
+	_, err = keeper.NewMsgServerImpl(&input.OPChildKeeper).RemoveSponsorship(nextCtx, types.NewMsgRemoveSponsorship(authtypes.NewModuleAddress(types.ModuleName).String(), "", sendTypeURL))
+	require.NoError(t, err)
+
+	found, err = input.OPChildKeeper.SponsorshipUsages.Has(nextCtx, collections.Join3(sendTypeURL, uint64(11), testutil.AddrsStr[2]))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// the budget is refreshed after the period
+	res, err = q.Sponsorship(ctx.WithBlockHeight(11), &types.QuerySponsorshipRequest{Account: testutil.AddrsStr[1], MsgTypeUrl: sendTypeURL})
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), res.Remaining)
+	require.Equal(t, uint64(11), res.Sponsorship.PeriodStart)
+
+	// replacing the sponsorship keeps the gas used
+	require.NoError(t, input.OPChildKeeper.RegisterSponsorship(ctx, testutil.AddrsStr[1], "", 60, 0, 0))
+	res, err = q.Sponsorship(ctx, &types.QuerySponsorshipRequest{Account: testutil.AddrsStr[1]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), res.Remaining)
+}
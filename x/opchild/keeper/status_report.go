@@ -0,0 +1,261 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// RecordOPinitChannel records the L2 side channel id of the opinit channel. The channel is
+// identified by a packet received on a channel whose counterparty is the L1 side channel registered
+// in the bridge config and whose connection is established with the L1 client. Once recorded, the
+// channel is never replaced.
+func (k Keeper) RecordOPinitChannel(ctx context.Context, portId, sourceChannel, destinationChannel string) error {
+	bridgeInfo, err := k.BridgeInfo.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if bridgeInfo.BridgeConfig.ChannelId == "" || bridgeInfo.BridgeConfig.ChannelId != sourceChannel {
+		return nil
+	}
+
+	if found, err := k.OPinitChannelId.Has(ctx); err != nil {
+		return err
+	} else if found {
+		return nil
+	}
+
+	if k.channelKeeper == nil {
+		return nil
+	}
+
+	// the counterparty channel id can be claimed by any chain, so resolve the channel end
+	// and check it is connected to the L1 client
+	channel, found := k.channelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), portId, destinationChannel)
+	if !found || channel.Counterparty.ChannelId != bridgeInfo.BridgeConfig.ChannelId {
+		return nil
+	}
+	if k.checkL1Channel(ctx, portId, destinationChannel) != nil {
+		return nil
+	}
+
+	return k.OPinitChannelId.Set(ctx, destinationChannel)
+}
+
+// OPinitChannel returns the L2 side channel id of the opinit channel, or an empty string if the
+// channel is not recorded yet.
+func (k Keeper) OPinitChannel(ctx context.Context) (string, error) {
+	channelId, err := k.OPinitChannelId.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+
+	return channelId, err
+}
+
+// ValidateOPinitChannel checks the given channel is the recorded opinit channel and it is
+// connected to the L1 client of the bridge. Packets carrying oracle prices or forced txs are
+// only accepted from this channel.
+func (k Keeper) ValidateOPinitChannel(ctx context.Context, portId, channelId string) error {
+	recordedChannelId, err := k.OPinitChannel(ctx)
+	if err != nil {
+		return err
+	} else if recordedChannelId == "" || recordedChannelId != channelId {
+		return types.ErrInvalidOPinitChannel.Wrapf("packet received from non-opinit channel %s", channelId)
+	}
+
+	return k.checkL1Channel(ctx, portId, channelId)
+}
+
+// checkL1Channel checks the connection of the given channel is established with the L1 client.
+func (k Keeper) checkL1Channel(ctx context.Context, portId, channelId string) error {
+	if k.channelKeeper == nil {
+		return types.ErrInvalidOPinitChannel.Wrap("channel keeper is not set")
+	}
+
+	l1ClientId, err := k.L1ClientId(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, connection, err := k.channelKeeper.GetChannelConnection(sdk.UnwrapSDKContext(ctx), portId, channelId)
+	if err != nil {
+		return err
+	} else if connection.ClientId != l1ClientId {
+		return types.ErrInvalidOPinitChannel.Wrapf("channel %s is not connected to the L1 client %s", channelId, l1ClientId)
+	}
+
+	return nil
+}
+
+// ReportL2Status sends the L2 status to L1 over the opinit channel once per the status report interval.
+// This should be called in EndBlocker after the shutdown process. A failure to send the report is logged
+// and retried at the next block.
+func (k Keeper) ReportL2Status(ctx context.Context) error {
+	if k.channelKeeper == nil {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	} else if params.StatusReportInterval == 0 {
+		return nil
+	}
+
+	channelId, err := k.OPinitChannelId.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight()) //nolint:gosec
+
+	lastHeight, err := k.LastStatusReport.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	} else if err == nil && height < lastHeight+params.StatusReportInterval {
+		return nil
+	}
+
+	data, err := k.BuildL2StatusReport(ctx)
+	if err != nil {
+		return err
+	}
+
+	// use cache context to discard partial state changes on failure
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+	if err := k.sendL2StatusReportPacket(cacheCtx, channelId, data); err != nil {
+		k.Logger(ctx).Error("failed to send l2 status report",
+			"channel_id", channelId,
+			"error", err.Error(),
+		)
+		return nil
+	}
+	writeCache()
+
+	return k.LastStatusReport.Set(ctx, height)
+}
+
+// OnTimeoutL2StatusReportPacket clears the last status report height, so the L2 status is
+// reported again at the next block.
+func (k Keeper) OnTimeoutL2StatusReportPacket(ctx context.Context) error {
+	return k.LastStatusReport.Remove(ctx)
+}
+
+// BuildL2StatusReport builds the L2 status report with the current validator set, the next L2 sequence
+// and the shutdown progress.
+func (k Keeper) BuildL2StatusReport(ctx context.Context) (ophosttypes.L2StatusReportPacketData, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bridgeInfo, err := k.BridgeInfo.Get(ctx)
+	if err != nil {
+		return ophosttypes.L2StatusReportPacketData{}, err
+	}
+
+	validators, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return ophosttypes.L2StatusReportPacketData{}, err
+	}
+
+	l2Validators := make([]ophosttypes.L2Validator, 0, len(validators))
+	for _, val := range validators {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return ophosttypes.L2StatusReportPacketData{}, err
+		}
+
+		consAddrStr, err := k.consensusAddressCodec.BytesToString(consAddr)
+		if err != nil {
+			return ophosttypes.L2StatusReportPacketData{}, err
+		}
+
+		l2Validators = append(l2Validators, ophosttypes.L2Validator{
+			Moniker:         val.GetMoniker(),
+			OperatorAddress: val.GetOperator(),
+			ConsAddress:     consAddrStr,
+			ConsPower:       val.ConsensusPower(),
+		})
+	}
+
+	nextL2Sequence, err := k.GetNextL2Sequence(ctx)
+	if err != nil {
+		return ophosttypes.L2StatusReportPacketData{}, err
+	}
+
+	nextForcedTxSequence, err := k.GetNextForcedTxSequence(ctx)
+	if err != nil {
+		return ophosttypes.L2StatusReportPacketData{}, err
+	}
+
+	nextL1Sequence, err := k.GetNextL1Sequence(ctx)
+	if err != nil {
+		return ophosttypes.L2StatusReportPacketData{}, err
+	}
+
+	// shutdown info is only reported when the bridge is disabled
+	var shutdownInfo *ophosttypes.L2ShutdownInfo
+	if bridgeInfo.BridgeConfig.BridgeDisabled {
+		info, err := k.ShutdownInfo.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return ophosttypes.L2StatusReportPacketData{}, err
+		}
+
+		shutdownInfo = &ophosttypes.L2ShutdownInfo{
+			LastAddr:  info.LastAddr,
+			LastBlock: info.LastBlock,
+		}
+	}
+
+	return ophosttypes.L2StatusReportPacketData{
+		BridgeId:             bridgeInfo.BridgeId,
+		Validators:           l2Validators,
+		NextL2Sequence:       nextL2Sequence,
+		ShutdownInfo:         shutdownInfo,
+		L2BlockHeight:        uint64(sdkCtx.BlockHeight()), //nolint:gosec
+		L2BlockTime:          sdkCtx.BlockTime().UnixNano(),
+		NextForcedTxSequence: nextForcedTxSequence,
+		NextL1Sequence:       nextL1Sequence,
+	}, nil
+}
+
+func (k Keeper) sendL2StatusReportPacket(ctx context.Context, channelId string, data ophosttypes.L2StatusReportPacketData) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	packetData := ophosttypes.NewL2StatusReportPacket(data)
+	timeoutTimestamp := uint64(sdkCtx.BlockTime().Add(ophosttypes.DefaultPacketTimeoutTimestamp).UnixNano()) //nolint:gosec
+
+	if _, err := k.channelKeeper.SendPacket(
+		sdkCtx,
+		types.PortID,
+		channelId,
+		ophosttypes.DefaultTransferPacketTimeoutHeight,
+		timeoutTimestamp,
+		packetData.GetBytes(),
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to send IBC packet")
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeL2StatusReportSent,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(data.BridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyL2BlockHeight, strconv.FormatUint(data.L2BlockHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyL2Sequence, strconv.FormatUint(data.NextL2Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyNumValidators, strconv.Itoa(len(data.Validators))),
+	))
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_RecordOPinitChannel(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	setOPinitChannelEnd(sdkCtx, input, "channel-1", "channel-0", "test-client-id")
+	setOPinitChannelEnd(sdkCtx, input, "channel-2", "channel-0", "other-client-id")
+	setOPinitChannelEnd(sdkCtx, input, "channel-3", "channel-0", "test-client-id")
+
+	// no bridge info
+	require.NoError(t, input.OPChildKeeper.RecordOPinitChannel(ctx, types.PortID, "channel-0", "channel-1"))
+	_, err := input.OPChildKeeper.OPinitChannelId.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	require.NoError(t, input.OPChildKeeper.BridgeInfo.Set(ctx, types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: testutil.AddrsStr[0],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			ChannelId: "channel-0",
+		},
+	}))
+
+	// packet from other channel
+	require.NoError(t, input.OPChildKeeper.RecordOPinitChannel(ctx, types.PortID, "channel-5", "channel-6"))
+	_, err = input.OPChildKeeper.OPinitChannelId.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// unknown channel end
+	require.NoError(t, input.OPChildKeeper.RecordOPinitChannel(ctx, types.PortID, "channel-0", "channel-6"))
+	_, err = input.OPChildKeeper.OPinitChannelId.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// spoofed counterparty channel id on a channel not connected to the L1 client
+	require.NoError(t, input.OPChildKeeper.RecordOPinitChannel(ctx, types.PortID, "channel-0", "channel-2"))
+	_, err = input.OPChildKeeper.OPinitChannelId.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	require.NoError(t, input.OPChildKeeper.RecordOPinitChannel(ctx, types.PortID, "channel-0", "channel-1"))
+	channelId, err := input.OPChildKeeper.OPinitChannelId.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", channelId)
+
+	// recorded channel is never replaced
+	require.NoError(t, input.OPChildKeeper.RecordOPinitChannel(ctx, types.PortID, "channel-0", "channel-3"))
+	channelId, err = input.OPChildKeeper.OPinitChannelId.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", channelId)
+}
+
+// setOPinitChannelEnd stores an opinit channel end whose connection is established with the given client.
+func setOPinitChannelEnd(ctx sdk.Context, input testutil.TestKeepers, channelId, counterpartyChannelId, clientId string) {
+	connectionId := "connection-" + channelId
+	input.IBCKeeper.ConnectionKeeper.SetConnection(ctx, connectionId, connectiontypes.ConnectionEnd{
+		State:    connectiontypes.OPEN,
+		ClientId: clientId,
+	})
+	input.IBCKeeper.ChannelKeeper.SetChannel(ctx, types.PortID, channelId, channeltypes.Channel{
+		State:    channeltypes.OPEN,
+		Ordering: channeltypes.UNORDERED,
+		Counterparty: channeltypes.Counterparty{
+			PortId:    types.PortID,
+			ChannelId: counterpartyChannelId,
+		},
+		ConnectionHops: []string{connectionId},
+	})
+}
+
+func Test_ValidateOPinitChannel(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	require.NoError(t, input.OPChildKeeper.BridgeInfo.Set(ctx, types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: testutil.AddrsStr[0],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			ChannelId: "channel-0",
+		},
+	}))
+
+	setOPinitChannelEnd(sdkCtx, input, "channel-1", "channel-0", "test-client-id")
+	setOPinitChannelEnd(sdkCtx, input, "channel-2", "channel-0", "other-client-id")
+
+	// channel not recorded yet
+	err := input.OPChildKeeper.ValidateOPinitChannel(ctx, types.PortID, "channel-1")
+	require.ErrorIs(t, err, types.ErrInvalidOPinitChannel)
+
+	require.NoError(t, input.OPChildKeeper.OPinitChannelId.Set(ctx, "channel-1"))
+	require.NoError(t, input.OPChildKeeper.ValidateOPinitChannel(ctx, types.PortID, "channel-1"))
+
+	// other channel
+	err = input.OPChildKeeper.ValidateOPinitChannel(ctx, types.PortID, "channel-2")
+	require.ErrorIs(t, err, types.ErrInvalidOPinitChannel)
+
+	// recorded channel is not connected to the L1 client
+	require.NoError(t, input.OPChildKeeper.OPinitChannelId.Set(ctx, "channel-2"))
+	err = input.OPChildKeeper.ValidateOPinitChannel(ctx, types.PortID, "channel-2")
+	require.ErrorIs(t, err, types.ErrInvalidOPinitChannel)
+}
+
+func Test_BuildL2StatusReport(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(100)
+
+	bridgeInfo := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: testutil.AddrsStr[0],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+	}
+	require.NoError(t, input.OPChildKeeper.BridgeInfo.Set(ctx, bridgeInfo))
+
+	valPubKeys := testutilsims.CreateTestPubKeys(1)
+	val, err := types.NewValidator(testutil.ValAddrs[0], valPubKeys[0], "val1")
+	require.NoError(t, err)
+	require.NoError(t, input.OPChildKeeper.SetValidator(ctx, val))
+
+	report, err := input.OPChildKeeper.BuildL2StatusReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), report.BridgeId)
+	require.Equal(t, uint64(100), report.L2BlockHeight)
+	require.Equal(t, uint64(1), report.NextL2Sequence)
+	require.Equal(t, uint64(1), report.NextL1Sequence)
+	require.Nil(t, report.ShutdownInfo)
+	require.Len(t, report.Validators, 1)
+	require.Equal(t, testutil.ValAddrsStr[0], report.Validators[0].OperatorAddress)
+	require.Equal(t, "val1", report.Validators[0].Moniker)
+
+	// shutdown info is reported once the bridge is disabled
+	bridgeInfo.BridgeConfig.BridgeDisabled = true
+	require.NoError(t, input.OPChildKeeper.BridgeInfo.Set(ctx, bridgeInfo))
+	require.NoError(t, input.OPChildKeeper.ShutdownInfo.Set(ctx, types.ShutdownInfo{LastBlock: true}))
+
+	report, err = input.OPChildKeeper.BuildL2StatusReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &ophosttypes.L2ShutdownInfo{LastBlock: true}, report.ShutdownInfo)
+}
+
+func Test_ReportL2Status(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	require.NoError(t, input.OPChildKeeper.BridgeInfo.Set(ctx, types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: testutil.AddrsStr[0],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+	}))
+
+	// report disabled
+	require.NoError(t, input.OPChildKeeper.ReportL2Status(ctx))
+	_, err := input.OPChildKeeper.LastStatusReport.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.StatusReportInterval = 10
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	// opinit channel is not known yet
+	require.NoError(t, input.OPChildKeeper.ReportL2Status(ctx))
+	_, err = input.OPChildKeeper.LastStatusReport.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// the channel does not exist, so the failure is logged and retried later
+	require.NoError(t, input.OPChildKeeper.OPinitChannelId.Set(ctx, "channel-0"))
+	require.NoError(t, input.OPChildKeeper.ReportL2Status(ctx))
+	_, err = input.OPChildKeeper.LastStatusReport.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// timeout clears the last report height
+	require.NoError(t, input.OPChildKeeper.LastStatusReport.Set(ctx, 1))
+	require.NoError(t, input.OPChildKeeper.OnTimeoutL2StatusReportPacket(ctx))
+	_, err = input.OPChildKeeper.LastStatusReport.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
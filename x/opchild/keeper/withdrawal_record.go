@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// recordWithdrawal stores the withdrawal record and appends the withdrawal hash to the withdrawal
+// merkle tree, which are used to build the withdrawal finalization message for L1.
+func (k Keeper) recordWithdrawal(ctx context.Context, l2Sequence uint64, sender, receiver string, amount sdk.Coin, baseDenom string) error {
+	bridgeInfo, err := k.BridgeInfo.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		// the withdrawal cannot be proven on L1 without the bridge
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !amount.Amount.IsUint64() {
+		return types.ErrInvalidAmount.Wrap("withdrawal amount exceeds uint64")
+	}
+
+	if err := k.SetWithdrawalRecord(ctx, types.WithdrawalRecord{
+		Sequence:  l2Sequence,
+		Sender:    sender,
+		Receiver:  receiver,
+		Amount:    amount,
+		BaseDenom: baseDenom,
+		Height:    uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()), //nolint:gosec
+	}); err != nil {
+		return err
+	}
+
+	withdrawalHash := ophosttypes.GenerateWithdrawalHash(bridgeInfo.BridgeId, l2Sequence, sender, receiver, baseDenom, amount.Amount.Uint64())
+	return k.appendWithdrawal(ctx, l2Sequence, withdrawalHash)
+}
+
+// SetWithdrawalRecord stores the withdrawal record with the indexes by sender and receiver.
+func (k Keeper) SetWithdrawalRecord(ctx context.Context, record types.WithdrawalRecord) error {
+	if err := k.WithdrawalRecords.Set(ctx, record.Sequence, record); err != nil {
+		return err
+	}
+
+	if err := k.SenderWithdrawals.Set(ctx, collections.Join(record.Sender, record.Sequence)); err != nil {
+		return err
+	}
+
+	return k.ReceiverWithdrawals.Set(ctx, collections.Join(record.Receiver, record.Sequence))
+}
+
+// GetWithdrawalRecord returns the withdrawal record of the l2 sequence.
+func (k Keeper) GetWithdrawalRecord(ctx context.Context, l2Sequence uint64) (types.WithdrawalRecord, error) {
+	return k.WithdrawalRecords.Get(ctx, l2Sequence)
+}
+
+// RemoveWithdrawalRecord removes the withdrawal record with the indexes by sender and receiver.
+func (k Keeper) RemoveWithdrawalRecord(ctx context.Context, record types.WithdrawalRecord) error {
+	if err := k.WithdrawalRecords.Remove(ctx, record.Sequence); err != nil {
+		return err
+	}
+
+	if err := k.SenderWithdrawals.Remove(ctx, collections.Join(record.Sender, record.Sequence)); err != nil {
+		return err
+	}
+
+	return k.ReceiverWithdrawals.Remove(ctx, collections.Join(record.Receiver, record.Sequence))
+}
+
+// PruneWithdrawalRecords removes the withdrawal records and the block hashes older than the
+// withdrawal record retention. This should be called in EndBlocker.
+func (k Keeper) PruneWithdrawalRecords(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if params.WithdrawalRecordRetention == 0 || height <= params.WithdrawalRecordRetention {
+		return nil
+	}
+
+	// the records are ordered by the height as well as the l2 sequence
+	pruneHeight := height - params.WithdrawalRecordRetention
+	var records []types.WithdrawalRecord
+	if err := k.WithdrawalRecords.Walk(ctx, nil, func(_ uint64, record types.WithdrawalRecord) (stop bool, err error) {
+		if record.Height > pruneHeight {
+			return true, nil
+		}
+
+		records = append(records, record)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if err := k.RemoveWithdrawalRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	return k.BlockHashes.Clear(ctx, new(collections.Range[uint64]).EndInclusive(pruneHeight))
+}
+
+// TrackBlockHash stores the hash of the current block, which is a component of the output root.
+func (k Keeper) TrackBlockHash(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if len(sdkCtx.HeaderHash()) == 0 {
+		return nil
+	}
+
+	return k.BlockHashes.Set(ctx, uint64(sdkCtx.BlockHeight()), sdkCtx.HeaderHash()) //nolint:gosec
+}
+
+// getWithdrawalSequenceRange returns the l2 sequence range of the withdrawals initiated in the
+// l2 block range.
+func (k Keeper) getWithdrawalSequenceRange(ctx context.Context, startBlock, endBlock uint64) (uint64, uint64, error) {
+	var first uint64
+	found := false
+	if err := k.WithdrawalRecords.Walk(ctx, nil, func(l2Sequence uint64, _ types.WithdrawalRecord) (stop bool, err error) {
+		first, found = l2Sequence, true
+		return true, nil
+	}); err != nil {
+		return 0, 0, err
+	} else if !found {
+		return 0, 0, types.ErrWithdrawalNotFound.Wrap("no withdrawal record")
+	}
+
+	nextL2Sequence, err := k.GetNextL2Sequence(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// the withdrawals in the range must be recorded from the start block
+	firstRecord, err := k.WithdrawalRecords.Get(ctx, first)
+	if err != nil {
+		return 0, 0, err
+	} else if first > ophosttypes.DefaultL2SequenceStart && firstRecord.Height >= startBlock {
+		return 0, 0, types.ErrWithdrawalNotFound.Wrapf("withdrawal records are not available from l2 block %d", startBlock)
+	}
+
+	// find the first l2 sequence whose height is over the given height
+	var searchErr error
+	search := func(height uint64) uint64 {
+		return first + uint64(sort.Search(int(nextL2Sequence-first), func(i int) bool { //nolint:gosec
+			record, err := k.WithdrawalRecords.Get(ctx, first+uint64(i)) //nolint:gosec
+			if err != nil {
+				searchErr = err
+				return true
+			}
+
+			return record.Height > height
+		}))
+	}
+
+	start, end := search(startBlock-1), search(endBlock)-1
+	if searchErr != nil {
+		return 0, 0, searchErr
+	} else if start > end {
+		return 0, 0, types.ErrWithdrawalNotFound.Wrapf("no withdrawal in l2 block range [%d, %d]", startBlock, endBlock)
+	}
+
+	return start, end, nil
+}
+
+// GetFinalizeTokenWithdrawalMsg builds the MsgFinalizeTokenWithdrawal of the withdrawal for the
+// output over the l2 block range. The sender defaults to the receiver of the withdrawal.
+func (k Keeper) GetFinalizeTokenWithdrawalMsg(
+	ctx context.Context,
+	l2Sequence, outputIndex, startBlock, endBlock uint64,
+	sender string,
+) (*ophosttypes.MsgFinalizeTokenWithdrawal, uint64, uint64, error) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if startBlock == 0 || startBlock > endBlock || endBlock > height {
+		return nil, 0, 0, types.ErrInvalidBlockHeight.Wrapf("invalid l2 block range [%d, %d]", startBlock, endBlock)
+	}
+
+	bridgeInfo, err := k.BridgeInfo.Get(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	record, err := k.WithdrawalRecords.Get(ctx, l2Sequence)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, 0, 0, types.ErrWithdrawalNotFound.Wrapf("l2 sequence %d", l2Sequence)
+	} else if err != nil {
+		return nil, 0, 0, err
+	} else if record.Height < startBlock || record.Height > endBlock {
+		return nil, 0, 0, types.ErrInvalidBlockHeight.Wrapf("withdrawal %d is not in l2 block range [%d, %d]", l2Sequence, startBlock, endBlock)
+	}
+
+	start, end, err := k.getWithdrawalSequenceRange(ctx, startBlock, endBlock)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	proof, err := k.GetWithdrawalProof(ctx, l2Sequence, start, end)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	blockHash, err := k.BlockHashes.Get(ctx, endBlock)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, 0, 0, types.ErrBlockHashNotFound.Wrapf("l2 block %d", endBlock)
+	} else if err != nil {
+		return nil, 0, 0, err
+	}
+
+	if sender == "" {
+		sender = record.Receiver
+	}
+
+	return ophosttypes.NewMsgFinalizeTokenWithdrawal(
+		sender,
+		bridgeInfo.BridgeId,
+		outputIndex,
+		l2Sequence,
+		proof.Proofs,
+		record.Sender,
+		record.Receiver,
+		sdk.Coin{Denom: record.BaseDenom, Amount: record.Amount.Amount},
+		[]byte{ophosttypes.OutputVersion},
+		proof.Root,
+		blockHash,
+	), start, end, nil
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_FinalizeTokenWithdrawalMsg(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	querier := keeper.NewQuerier(&input.OPChildKeeper)
+
+	baseDenom := "test_token"
+	denom, accountAddr := setupWithdrawalBridge(t, ctx, input, baseDenom)
+
+	// withdraw 2 at block 10, 1 at block 11 and 2 at block 12
+	var leaves [][]byte
+	blockHashes := map[int64][]byte{}
+	for i, height := range []int64{10, 10, 11, 12, 12} {
+		blockHash := make([]byte, 32)
+		blockHash[0] = byte(height)
+		blockHashes[height] = blockHash
+
+		ctx = ctx.WithBlockHeight(height).WithHeaderHash(blockHash)
+		require.NoError(t, input.OPChildKeeper.TrackBlockHash(ctx))
+
+		amount := uint64(i + 1)
+		_, err := ms.InitiateTokenWithdrawal(ctx, types.NewMsgInitiateTokenWithdrawal(accountAddr, testutil.AddrsStr[2], sdk.NewCoin(denom, math.NewIntFromUint64(amount))))
+		require.NoError(t, err)
+
+		leaf := ophosttypes.GenerateWithdrawalHash(1, uint64(i+1), accountAddr, testutil.AddrsStr[2], baseDenom, amount)
+		leaves = append(leaves, leaf[:])
+	}
+
+	// the output over the l2 blocks [11, 12]
+	res, err := querier.FinalizeTokenWithdrawalMsg(ctx, &types.QueryFinalizeTokenWithdrawalMsgRequest{
+		Sequence:         4,
+		OutputIndex:      2,
+		StartBlockNumber: 11,
+		EndBlockNumber:   12,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.StartSequence)
+	require.Equal(t, uint64(5), res.EndSequence)
+
+	msg := res.Msg
+	require.NoError(t, msg.Validate(input.AccountKeeper.AddressCodec()))
+	require.Equal(t, testutil.AddrsStr[2], msg.Sender)
+	require.Equal(t, uint64(1), msg.BridgeId)
+	require.Equal(t, uint64(2), msg.OutputIndex)
+	require.Equal(t, uint64(4), msg.Sequence)
+	require.Equal(t, accountAddr, msg.From)
+	require.Equal(t, testutil.AddrsStr[2], msg.To)
+	require.Equal(t, sdk.NewInt64Coin(baseDenom, 4), msg.Amount)
+	require.Equal(t, []byte{ophosttypes.OutputVersion}, msg.Version)
+	require.Equal(t, nodeHash(nodeHash(leaves[2], leaves[3]), nodeHash(leaves[4], leaves[4])), msg.StorageRoot)
+	require.Equal(t, blockHashes[12], msg.LastBlockHash)
+
+	// the message is verified against the output root as on L1
+	withdrawalHash := ophosttypes.GenerateWithdrawalHash(msg.BridgeId, msg.Sequence, msg.From, msg.To, msg.Amount.Denom, msg.Amount.Amount.Uint64())
+	storageRoot := ophosttypes.GenerateRootHashFromProofs(withdrawalHash, msg.WithdrawalProofs)
+	require.Equal(t, msg.StorageRoot, storageRoot[:])
+
+	// the withdrawal is not in the output
+	_, err = querier.FinalizeTokenWithdrawalMsg(ctx, &types.QueryFinalizeTokenWithdrawalMsgRequest{
+		Sequence:         4,
+		StartBlockNumber: 10,
+		EndBlockNumber:   11,
+	})
+	require.Error(t, err)
+
+	// the output over the future block
+	_, err = querier.FinalizeTokenWithdrawalMsg(ctx, &types.QueryFinalizeTokenWithdrawalMsgRequest{
+		Sequence:         4,
+		StartBlockNumber: 11,
+		EndBlockNumber:   13,
+	})
+	require.Error(t, err)
+}
+
+func Test_WithdrawalRecords(t *testing.T) {
+	ctx_, input := testutil.CreateTestInput(t, false)
+	ctx := sdk.UnwrapSDKContext(ctx_)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	querier := keeper.NewQuerier(&input.OPChildKeeper)
+
+	denom, accountAddr := setupWithdrawalBridge(t, ctx, input, "test_token")
+
+	// withdraw to AddrsStr[2] at block 10 and 11, and to AddrsStr[3] at block 12
+	for i, height := range []int64{10, 11, 12} {
+		ctx = ctx.WithBlockHeight(height).WithHeaderHash(make([]byte, 32))
+		require.NoError(t, input.OPChildKeeper.TrackBlockHash(ctx))
+
+		receiver := testutil.AddrsStr[2]
+		if height == 12 {
+			receiver = testutil.AddrsStr[3]
+		}
+
+		_, err := ms.InitiateTokenWithdrawal(ctx, types.NewMsgInitiateTokenWithdrawal(accountAddr, receiver, sdk.NewCoin(denom, math.NewInt(int64(i+1)))))
+		require.NoError(t, err)
+	}
+
+	res, err := querier.Withdrawal(ctx, &types.QueryWithdrawalRequest{Sequence: 2})
+	require.NoError(t, err)
+	require.Equal(t, types.WithdrawalRecord{
+		Sequence:  2,
+		Sender:    accountAddr,
+		Receiver:  testutil.AddrsStr[2],
+		Amount:    sdk.NewInt64Coin(denom, 2),
+		BaseDenom: "test_token",
+		Height:    11,
+	}, res.Withdrawal)
+
+	_, err = querier.Withdrawal(ctx, &types.QueryWithdrawalRequest{Sequence: 4})
+	require.Error(t, err)
+
+	bySender, err := querier.WithdrawalsBySender(ctx, &types.QueryWithdrawalsBySenderRequest{
+		Sender:     accountAddr,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, bySender.Withdrawals, 2)
+	require.Equal(t, uint64(1), bySender.Withdrawals[0].Sequence)
+	require.Equal(t, uint64(2), bySender.Withdrawals[1].Sequence)
+
+	bySender, err = querier.WithdrawalsBySender(ctx, &types.QueryWithdrawalsBySenderRequest{
+		Sender:     accountAddr,
+		Pagination: &query.PageRequest{Key: bySender.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, bySender.Withdrawals, 1)
+	require.Equal(t, uint64(3), bySender.Withdrawals[0].Sequence)
+
+	byReceiver, err := querier.WithdrawalsByReceiver(ctx, &types.QueryWithdrawalsByReceiverRequest{Receiver: testutil.AddrsStr[3]})
+	require.NoError(t, err)
+	require.Len(t, byReceiver.Withdrawals, 1)
+	require.Equal(t, uint64(3), byReceiver.Withdrawals[0].Sequence)
+
+	// keep the records of the last 2 blocks
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.WithdrawalRecordRetention = 2
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(13)
+	require.NoError(t, input.OPChildKeeper.PruneWithdrawalRecords(ctx))
+
+	_, err = querier.Withdrawal(ctx, &types.QueryWithdrawalRequest{Sequence: 2})
+	require.Error(t, err)
+	_, err = querier.Withdrawal(ctx, &types.QueryWithdrawalRequest{Sequence: 3})
+	require.NoError(t, err)
+
+	byReceiver, err = querier.WithdrawalsByReceiver(ctx, &types.QueryWithdrawalsByReceiverRequest{Receiver: testutil.AddrsStr[2]})
+	require.NoError(t, err)
+	require.Empty(t, byReceiver.Withdrawals)
+
+	_, err = input.OPChildKeeper.BlockHashes.Get(ctx, 11)
+	require.Error(t, err)
+	_, err = input.OPChildKeeper.BlockHashes.Get(ctx, 12)
+	require.NoError(t, err)
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// appendWithdrawal appends the withdrawal hash to the withdrawal merkle tree as the leaf of the
+// l2 sequence. Every completed pair of nodes is merged into the parent node, so the subtrees
+// aligned to the first l2 sequence are stored in state.
+func (k Keeper) appendWithdrawal(ctx context.Context, l2Sequence uint64, withdrawalHash [32]byte) error {
+	node := withdrawalHash[:]
+
+	level, index := uint64(0), l2Sequence-ophosttypes.DefaultL2SequenceStart
+	if err := k.WithdrawalTree.Set(ctx, collections.Join(level, index), node); err != nil {
+		return err
+	}
+
+	for index%2 == 1 {
+		sibling, err := k.WithdrawalTree.Get(ctx, collections.Join(level, index-1))
+		if errors.Is(err, collections.ErrNotFound) {
+			// the withdrawals before the bridge or the upgrade are not recorded
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		parent := ophosttypes.GenerateNodeHash(sibling, node)
+		node = parent[:]
+		level, index = level+1, index/2
+		if err := k.WithdrawalTree.Set(ctx, collections.Join(level, index), node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withdrawalTree is the withdrawal merkle tree over the l2 sequence range. The leaves are padded
+// with the last leaf up to the power of two, which is the tree built by the bridge executor.
+type withdrawalTree struct {
+	k     Keeper
+	ctx   context.Context
+	start uint64
+	end   uint64
+}
+
+// newWithdrawalTree returns the withdrawal merkle tree over the l2 sequence range. The zero
+// sequences default to the first and the last l2 sequence.
+func (k Keeper) newWithdrawalTree(ctx context.Context, start, end uint64) (withdrawalTree, error) {
+	if start == 0 {
+		start = ophosttypes.DefaultL2SequenceStart
+	}
+
+	if end == 0 {
+		nextL2Sequence, err := k.GetNextL2Sequence(ctx)
+		if err != nil {
+			return withdrawalTree{}, err
+		}
+
+		end = nextL2Sequence - 1
+	}
+
+	if start > end {
+		return withdrawalTree{}, types.ErrInvalidSequence.Wrapf("invalid withdrawal range [%d, %d]", start, end)
+	}
+
+	return withdrawalTree{k: k, ctx: ctx, start: start, end: end}, nil
+}
+
+// height returns the height of the tree, which is zero for a single withdrawal.
+func (t withdrawalTree) height() uint64 {
+	height := uint64(0)
+	for size := t.end - t.start + 1; size > 1<<height; {
+		height++
+	}
+
+	return height
+}
+
+// node returns the node at the level and the index relative to the start of the range.
+func (t withdrawalTree) node(level, index uint64) ([]byte, error) {
+	first := t.start + index<<level
+	if first > t.end {
+		return t.padding(level)
+	}
+
+	if level == 0 {
+		return t.leaf(first)
+	}
+
+	// use the stored subtree if it is aligned to the first l2 sequence and fully withdrawn
+	offset := first - ophosttypes.DefaultL2SequenceStart
+	if last := first + 1<<level - 1; last <= t.end && offset%(1<<level) == 0 {
+		node, err := t.k.WithdrawalTree.Get(t.ctx, collections.Join(level, offset>>level))
+		if err == nil {
+			return node, nil
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	left, err := t.node(level-1, index*2)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := t.node(level-1, index*2+1)
+	if err != nil {
+		return nil, err
+	}
+
+	node := ophosttypes.GenerateNodeHash(left, right)
+	return node[:], nil
+}
+
+// padding returns the node at the level which covers only the padded leaves, i.e. the subtree
+// whose leaves are all the last leaf.
+func (t withdrawalTree) padding(level uint64) ([]byte, error) {
+	node, err := t.leaf(t.end)
+	if err != nil {
+		return nil, err
+	}
+
+	for ; level > 0; level-- {
+		parent := ophosttypes.GenerateNodeHash(node, node)
+		node = parent[:]
+	}
+
+	return node, nil
+}
+
+func (t withdrawalTree) leaf(l2Sequence uint64) ([]byte, error) {
+	leaf, err := t.k.WithdrawalTree.Get(t.ctx, collections.Join(uint64(0), l2Sequence-ophosttypes.DefaultL2SequenceStart))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(types.ErrWithdrawalNotFound, "l2 sequence %d", l2Sequence)
+	}
+
+	return leaf, err
+}
+
+func (t withdrawalTree) root() ([]byte, error) {
+	return t.node(t.height(), 0)
+}
+
+// proof returns the sibling nodes from the leaf of the l2 sequence to the root.
+func (t withdrawalTree) proof(l2Sequence uint64) ([][]byte, error) {
+	if l2Sequence < t.start || l2Sequence > t.end {
+		return nil, types.ErrInvalidSequence.Wrapf("l2 sequence %d is out of the range [%d, %d]", l2Sequence, t.start, t.end)
+	}
+
+	height := t.height()
+	proofs := make([][]byte, 0, height)
+	index := l2Sequence - t.start
+	for level := uint64(0); level < height; level++ {
+		sibling, err := t.node(level, index^1)
+		if err != nil {
+			return nil, err
+		}
+
+		proofs = append(proofs, sibling)
+		index /= 2
+	}
+
+	return proofs, nil
+}
+
+// GetWithdrawalRoot returns the root of the withdrawal merkle tree over the l2 sequence range,
+// with the resolved range.
+func (k Keeper) GetWithdrawalRoot(ctx context.Context, start, end uint64) ([]byte, uint64, uint64, error) {
+	tree, err := k.newWithdrawalTree(ctx, start, end)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	root, err := tree.root()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return root, tree.start, tree.end, nil
+}
+
+// GetWithdrawalProof returns the withdrawal hash of the l2 sequence and its inclusion proof in
+// the withdrawal merkle tree over the l2 sequence range.
+func (k Keeper) GetWithdrawalProof(ctx context.Context, l2Sequence, start, end uint64) (types.QueryWithdrawalProofResponse, error) {
+	tree, err := k.newWithdrawalTree(ctx, start, end)
+	if err != nil {
+		return types.QueryWithdrawalProofResponse{}, err
+	}
+
+	withdrawalHash, err := tree.leaf(l2Sequence)
+	if err != nil {
+		return types.QueryWithdrawalProofResponse{}, err
+	}
+
+	proofs, err := tree.proof(l2Sequence)
+	if err != nil {
+		return types.QueryWithdrawalProofResponse{}, err
+	}
+
+	root, err := tree.root()
+	if err != nil {
+		return types.QueryWithdrawalProofResponse{}, err
+	}
+
+	return types.QueryWithdrawalProofResponse{
+		WithdrawalHash: withdrawalHash,
+		Proofs:         proofs,
+		Root:           root,
+		StartSequence:  tree.start,
+		EndSequence:    tree.end,
+	}, nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/testutil"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// nodeHash returns the parent node of the two nodes.
+func nodeHash(a, b []byte) []byte {
+	node := ophosttypes.GenerateNodeHash(a, b)
+	return node[:]
+}
+
+// setupWithdrawalBridge sets the bridge info and funds an account with the l2 token of the
+// base denom, and returns the l2 denom and the account.
+func setupWithdrawalBridge(t *testing.T, ctx context.Context, input testutil.TestKeepers, baseDenom string) (string, string) {
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: testutil.AddrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challenger: testutil.AddrsStr[2],
+			Proposer:   testutil.AddrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: testutil.AddrsStr[4],
+				ChainType: ophosttypes.BatchInfo_INITIA,
+			},
+			SubmissionInterval:    time.Minute,
+			FinalizationPeriod:    time.Hour,
+			SubmissionStartHeight: 1,
+		},
+	}
+
+	_, err := ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(testutil.AddrsStr[0], info))
+	require.NoError(t, err)
+
+	denom := ophosttypes.L2Denom(1, baseDenom)
+	_, err = ms.FinalizeTokenDeposit(ctx, types.NewMsgFinalizeTokenDeposit(testutil.AddrsStr[0], "anyformataddr", testutil.AddrsStr[1], sdk.NewCoin(denom, math.NewInt(100)), 1, 1, baseDenom, nil))
+	require.NoError(t, err)
+
+	account := input.Faucet.NewFundedAccount(ctx, sdk.NewCoin(denom, math.NewInt(1_000_000)))
+	accountAddr, err := input.AccountKeeper.AddressCodec().BytesToString(account)
+	require.NoError(t, err)
+
+	return denom, accountAddr
+}
+
+func Test_WithdrawalTree(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(&input.OPChildKeeper)
+	querier := keeper.NewQuerier(&input.OPChildKeeper)
+
+	baseDenom := "test_token"
+	denom, accountAddr := setupWithdrawalBridge(t, ctx, input, baseDenom)
+
+	// no withdrawal yet
+	_, err := querier.WithdrawalRoot(ctx, &types.QueryWithdrawalRootRequest{})
+	require.Error(t, err)
+
+	var leaves [][]byte
+	for i := uint64(1); i <= 7; i++ {
+		_, err = ms.InitiateTokenWithdrawal(ctx, types.NewMsgInitiateTokenWithdrawal(accountAddr, testutil.AddrsStr[2], sdk.NewCoin(denom, math.NewIntFromUint64(i))))
+		require.NoError(t, err)
+
+		leaf := ophosttypes.GenerateWithdrawalHash(1, i, accountAddr, testutil.AddrsStr[2], baseDenom, i)
+		leaves = append(leaves, leaf[:])
+	}
+
+	// the leaves are padded with the last leaf up to the power of two
+	l := func(seq int) []byte { return leaves[seq-1] }
+	for _, tc := range []struct {
+		start, end uint64
+		root       []byte
+	}{
+		{1, 1, l(1)},
+		{1, 4, nodeHash(nodeHash(l(1), l(2)), nodeHash(l(3), l(4)))},
+		{1, 7, nodeHash(nodeHash(nodeHash(l(1), l(2)), nodeHash(l(3), l(4))), nodeHash(nodeHash(l(5), l(6)), nodeHash(l(7), l(7))))},
+		{3, 5, nodeHash(nodeHash(l(3), l(4)), nodeHash(l(5), l(5)))},
+		{2, 7, nodeHash(nodeHash(nodeHash(l(2), l(3)), nodeHash(l(4), l(5))), nodeHash(nodeHash(l(6), l(7)), nodeHash(l(7), l(7))))},
+		{5, 7, nodeHash(nodeHash(l(5), l(6)), nodeHash(l(7), l(7)))},
+	} {
+		res, err := querier.WithdrawalRoot(ctx, &types.QueryWithdrawalRootRequest{StartSequence: tc.start, EndSequence: tc.end})
+		require.NoError(t, err)
+
+		root := tc.root
+		require.Equal(t, root, res.Root, "range [%d, %d]", tc.start, tc.end)
+
+		// every withdrawal in the range is proven against the root
+		for seq := tc.start; seq <= tc.end; seq++ {
+			proofRes, err := querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: seq, StartSequence: tc.start, EndSequence: tc.end})
+			require.NoError(t, err)
+			require.Equal(t, leaves[seq-1], proofRes.WithdrawalHash)
+			require.Equal(t, root, proofRes.Root)
+
+			computed := ophosttypes.GenerateRootHashFromProofs([32]byte(proofRes.WithdrawalHash), proofRes.Proofs)
+			require.Equal(t, root, computed[:], "sequence %d in range [%d, %d]", seq, tc.start, tc.end)
+		}
+	}
+
+	// the range defaults to all withdrawals
+	res, err := querier.WithdrawalRoot(ctx, &types.QueryWithdrawalRootRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.StartSequence)
+	require.Equal(t, uint64(7), res.EndSequence)
+	require.Equal(t, nodeHash(nodeHash(nodeHash(l(1), l(2)), nodeHash(l(3), l(4))), nodeHash(nodeHash(l(5), l(6)), nodeHash(l(7), l(7)))), res.Root)
+
+	// out of range
+	_, err = querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: 5, StartSequence: 1, EndSequence: 4})
+	require.Error(t, err)
+
+	// not withdrawn yet
+	_, err = querier.WithdrawalRoot(ctx, &types.QueryWithdrawalRootRequest{StartSequence: 1, EndSequence: 8})
+	require.Error(t, err)
+
+	// invalid range
+	_, err = querier.WithdrawalRoot(ctx, &types.QueryWithdrawalRootRequest{StartSequence: 5, EndSequence: 4})
+	require.Error(t, err)
+}
+
+func Test_WithdrawalTree_FixedRoots(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	querier := keeper.NewQuerier(&input.OPChildKeeper)
+
+	// the leaf of the l2 sequence i is 32 bytes of i
+	for i := uint64(1); i <= 7; i++ {
+		require.NoError(t, input.OPChildKeeper.WithdrawalTree.Set(ctx, collections.Join(uint64(0), i-1), bytes.Repeat([]byte{byte(i)}, 32)))
+	}
+
+	// the roots are computed independently by the tree of the bridge executor
+	for _, tc := range []struct {
+		start, end uint64
+		root       string
+	}{
+		{1, 1, "0101010101010101010101010101010101010101010101010101010101010101"},
+		{1, 4, "bb597d330f7c30d427d6b421b4fb9d7384ddcdfd472b1b17b4aa9b974545d4b6"},
+		{1, 7, "e44b710f51ddc9fbe847b46cdb81d2f968a54d6e7341f2609c18d7a72ccdbc39"},
+		{3, 5, "8ced09a6c660701d2b6948d10432526fd787e0c71d7ca6d85e543b69628fcbf3"},
+		{2, 7, "cc5a29b93096d4fc76441e8da78061178e14ae9e2f97f0c85d607a0d68fa2dae"},
+		{5, 7, "12e5f0d6bd2aa86f826533a18555151fbaba2aed9a8987d3c24200f94a568c4d"},
+	} {
+		res, err := querier.WithdrawalRoot(ctx, &types.QueryWithdrawalRootRequest{StartSequence: tc.start, EndSequence: tc.end})
+		require.NoError(t, err)
+		require.Equal(t, tc.root, hex.EncodeToString(res.Root), "range [%d, %d]", tc.start, tc.end)
+
+		for seq := tc.start; seq <= tc.end; seq++ {
+			proofRes, err := querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: seq, StartSequence: tc.start, EndSequence: tc.end})
+			require.NoError(t, err)
+
+			computed := ophosttypes.GenerateRootHashFromProofs([32]byte(proofRes.WithdrawalHash), proofRes.Proofs)
+			require.Equal(t, tc.root, hex.EncodeToString(computed[:]), "sequence %d in range [%d, %d]", seq, tc.start, tc.end)
+		}
+	}
+}
@@ -7,17 +7,26 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/block-sdk/v2/block/base"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
 )
 
 type FeeWhitelistKeeper interface {
 	FeeWhitelist(ctx context.Context) ([]string, error)
 }
 
+type SponsorshipKeeper interface {
+	FindSponsorship(ctx context.Context, feePayer []byte, msgs []sdk.Msg, gas uint64) (types.Sponsorship, bool, error)
+}
+
 // FreeLaneMatchHandler returns the default match handler for the free lane. The
-// default implementation matches fee payers that are in the fee whitelist.
+// default implementation matches fee payers that are in the fee whitelist, and
+// zero fee txs whose gas limit is covered by a sponsorship if the sponsorship
+// keeper is set.
 type FreeLaneMatchHandler struct {
 	ac  address.Codec
 	fwk FeeWhitelistKeeper
+	sk  SponsorshipKeeper
 }
 
 func NewFreeLaneMatchHandler(ac address.Codec, fwk FeeWhitelistKeeper) FreeLaneMatchHandler {
@@ -27,6 +36,12 @@ func NewFreeLaneMatchHandler(ac address.Codec, fwk FeeWhitelistKeeper) FreeLaneM
 	}
 }
 
+// WithSponsorshipKeeper sets the sponsorship keeper to match the sponsored txs.
+func (h FreeLaneMatchHandler) WithSponsorshipKeeper(sk SponsorshipKeeper) FreeLaneMatchHandler {
+	h.sk = sk
+	return h
+}
+
 func (h FreeLaneMatchHandler) MatchHandler() base.MatchHandler {
 	return func(ctx sdk.Context, tx sdk.Tx) bool {
 		feeTx, ok := tx.(sdk.FeeTx)
@@ -54,6 +69,11 @@ func (h FreeLaneMatchHandler) MatchHandler() base.MatchHandler {
 			}
 		}
 
+		if h.sk != nil && feeTx.GetFee().IsZero() && feeTx.FeeGranter() == nil {
+			_, found, err := h.sk.FindSponsorship(ctx, feeTx.FeePayer(), tx.GetMsgs(), feeTx.GetGas())
+			return err == nil && found
+		}
+
 		return false
 	}
 }
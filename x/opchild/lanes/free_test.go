@@ -1,6 +1,7 @@
 package lanes_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
@@ -14,6 +15,7 @@ import (
 	protov2 "google.golang.org/protobuf/proto"
 
 	"github.com/initia-labs/OPinit/x/opchild/lanes"
+	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
 )
 
 var _ lanes.FeeWhitelistKeeper = MockFeeWhitelistKeeper{}
@@ -27,6 +29,23 @@ func (m MockFeeWhitelistKeeper) FeeWhitelist(ctx context.Context) ([]string, err
 	return m.whitelist, nil
 }
 
+var _ lanes.SponsorshipKeeper = MockSponsorshipKeeper{}
+
+type MockSponsorshipKeeper struct {
+	sponsored [][]byte
+}
+
+// FindSponsorship implements lanes.SponsorshipKeeper.
+func (m MockSponsorshipKeeper) FindSponsorship(ctx context.Context, feePayer []byte, msgs []sdk.Msg, gas uint64) (opchildtypes.Sponsorship, bool, error) {
+	for _, sponsored := range m.sponsored {
+		if bytes.Equal(sponsored, feePayer) {
+			return opchildtypes.Sponsorship{GasBudget: gas}, true, nil
+		}
+	}
+
+	return opchildtypes.Sponsorship{}, false, nil
+}
+
 func Test_FreeLaneMatchHandler(t *testing.T) {
 	ctx := sdk.NewContext(nil, types.Header{}, false, log.NewNopLogger())
 	ac := address.NewBech32Codec("init")
@@ -69,6 +88,44 @@ func Test_FreeLaneMatchHandler(t *testing.T) {
 	}))
 }
 
+func Test_FreeLaneMatchHandler_Sponsorship(t *testing.T) {
+	ctx := sdk.NewContext(nil, types.Header{}, false, log.NewNopLogger())
+	ac := address.NewBech32Codec("init")
+
+	addr1, err := ac.BytesToString([]byte{0, 1, 2, 3})
+	require.NoError(t, err)
+
+	fwk := MockFeeWhitelistKeeper{
+		whitelist: []string{addr1},
+	}
+	sk := MockSponsorshipKeeper{
+		sponsored: [][]byte{{3, 4, 5, 6}},
+	}
+
+	// without the sponsorship keeper, the sponsored tx is not matched
+	handler := lanes.NewFreeLaneMatchHandler(ac, fwk).MatchHandler()
+	require.False(t, handler(ctx, MockTx{
+		feePayer: []byte{3, 4, 5, 6},
+	}))
+
+	handler = lanes.NewFreeLaneMatchHandler(ac, fwk).WithSponsorshipKeeper(sk).MatchHandler()
+	require.True(t, handler(ctx, MockTx{
+		feePayer: []byte{0, 1, 2, 3},
+	}))
+	require.True(t, handler(ctx, MockTx{
+		feePayer: []byte{3, 4, 5, 6},
+	}))
+	require.False(t, handler(ctx, MockTx{
+		feePayer: []byte{2, 3, 4, 5},
+	}))
+
+	// the fee granted tx is not sponsored
+	require.False(t, handler(ctx, MockTx{
+		feePayer:   []byte{3, 4, 5, 6},
+		feeGranter: []byte{2, 3, 4, 5},
+	}))
+}
+
 var _ sdk.Tx = MockTx{}
 var _ sdk.FeeTx = &MockTx{}
 
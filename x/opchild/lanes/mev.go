@@ -1,6 +1,8 @@
 package lanes
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	auctiontypes "github.com/skip-mev/block-sdk/v2/x/auction/types"
@@ -8,6 +10,10 @@ import (
 
 var _ auctiontypes.RewardsAddressProvider = (*RewardsAddressProvider)(nil)
 
+type MEVRewardsKeeper interface {
+	MEVRewardsAddress(ctx context.Context) (sdk.AccAddress, error)
+}
+
 // NewRewardsAddressProvider returns a new RewardsAddressProvider from a staking + distribution keeper
 func NewRewardsAddressProvider(feeCollectorName string) *RewardsAddressProvider {
 	return &RewardsAddressProvider{
@@ -19,9 +25,22 @@ func NewRewardsAddressProvider(feeCollectorName string) *RewardsAddressProvider
 // to determine the address to which the rewards from the most recent block's auction are sent.
 type RewardsAddressProvider struct {
 	feeCollectorName string
+	mrk              MEVRewardsKeeper
 }
 
-// GetRewardsAddress returns the address of the proposer of the previous block
+// WithMEVRewardsKeeper sets the keeper which splits the auction proceeds between the fee
+// collector and the mev rewards address of the opchild params.
+func (rap *RewardsAddressProvider) WithMEVRewardsKeeper(mrk MEVRewardsKeeper) *RewardsAddressProvider {
+	rap.mrk = mrk
+	return rap
+}
+
+// GetRewardsAddress returns the fee collector address, or the escrow address of the auction
+// proceeds to be split if the mev rewards keeper is set.
 func (rap *RewardsAddressProvider) GetRewardsAddress(ctx sdk.Context) (sdk.AccAddress, error) {
+	if rap.mrk != nil {
+		return rap.mrk.MEVRewardsAddress(ctx)
+	}
+
 	return authtypes.NewModuleAddress(rap.feeCollectorName), nil
 }
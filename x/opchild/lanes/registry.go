@@ -0,0 +1,95 @@
+package lanes
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+const (
+	SystemLaneName  = types.SystemLaneName
+	FreeLaneName    = types.FreeLaneName
+	MEVLaneName     = types.MEVLaneName
+	DefaultLaneName = types.DefaultLaneName
+)
+
+type LaneConfigKeeper interface {
+	GetParams(ctx context.Context) (types.Params, error)
+}
+
+// LaneRegistry applies the lane configs of the opchild params to the lanes built by the app.
+// The match handler of a disabled lane matches no tx, and the prepare lane handler of a lane
+// proposes no tx when disabled and at most the max block space of its config otherwise.
+type LaneRegistry struct {
+	lck LaneConfigKeeper
+}
+
+func NewLaneRegistry(lck LaneConfigKeeper) LaneRegistry {
+	return LaneRegistry{
+		lck: lck,
+	}
+}
+
+// LaneConfig returns the config of the lane in the opchild params. It returns false if the lane
+// has no config, in which case the lane is enabled with its own max block space.
+func (r LaneRegistry) LaneConfig(ctx context.Context, name string) (types.LaneConfig, bool) {
+	params, err := r.lck.GetParams(ctx)
+	if err != nil {
+		return types.LaneConfig{}, false
+	}
+
+	for _, config := range params.LaneConfigs {
+		if config.Name == name {
+			return config, true
+		}
+	}
+
+	return types.LaneConfig{}, false
+}
+
+// IsEnabled returns true if the lane is not disabled by the opchild params.
+func (r LaneRegistry) IsEnabled(ctx context.Context, name string) bool {
+	config, found := r.LaneConfig(ctx, name)
+	return !found || !config.Disabled
+}
+
+// MatchHandler wraps the match handler of the lane, so the lane matches no tx when disabled.
+func (r LaneRegistry) MatchHandler(name string, handler base.MatchHandler) base.MatchHandler {
+	return func(ctx sdk.Context, tx sdk.Tx) bool {
+		if !r.IsEnabled(ctx, name) {
+			return false
+		}
+
+		return handler(ctx, tx)
+	}
+}
+
+// PrepareLaneHandler wraps the prepare lane handler of the lane, so the lane proposes no tx when
+// disabled and the limit of the lane is bounded by the max block space of its config.
+func (r LaneRegistry) PrepareLaneHandler(name string, handler base.PrepareLaneHandler) base.PrepareLaneHandler {
+	return func(ctx sdk.Context, proposal proposals.Proposal, limit proposals.LaneLimits) ([]sdk.Tx, []sdk.Tx, error) {
+		config, found := r.LaneConfig(ctx, name)
+		if !found {
+			return handler(ctx, proposal, limit)
+		} else if config.Disabled {
+			return nil, nil, nil
+		}
+
+		if config.MaxBlockSpace.IsPositive() {
+			configLimit := proposal.GetLaneLimits(config.MaxBlockSpace)
+			if configLimit.MaxTxBytes < limit.MaxTxBytes {
+				limit.MaxTxBytes = configLimit.MaxTxBytes
+			}
+			if configLimit.MaxGasLimit < limit.MaxGasLimit {
+				limit.MaxGasLimit = configLimit.MaxGasLimit
+			}
+		}
+
+		return handler(ctx, proposal, limit)
+	}
+}
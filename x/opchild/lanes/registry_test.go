@@ -0,0 +1,83 @@
+package lanes_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/lanes"
+	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+var _ lanes.LaneConfigKeeper = MockLaneConfigKeeper{}
+
+type MockLaneConfigKeeper struct {
+	configs []opchildtypes.LaneConfig
+}
+
+// GetParams implements lanes.LaneConfigKeeper.
+func (m MockLaneConfigKeeper) GetParams(ctx context.Context) (opchildtypes.Params, error) {
+	params := opchildtypes.DefaultParams()
+	params.LaneConfigs = m.configs
+	return params, nil
+}
+
+var _ lanes.MEVRewardsKeeper = MockMEVRewardsKeeper{}
+
+type MockMEVRewardsKeeper struct {
+	addr sdk.AccAddress
+}
+
+// MEVRewardsAddress implements lanes.MEVRewardsKeeper.
+func (m MockMEVRewardsKeeper) MEVRewardsAddress(ctx context.Context) (sdk.AccAddress, error) {
+	return m.addr, nil
+}
+
+func Test_LaneRegistry(t *testing.T) {
+	ctx := sdk.NewContext(nil, types.Header{}, false, log.NewNopLogger())
+
+	registry := lanes.NewLaneRegistry(MockLaneConfigKeeper{
+		configs: []opchildtypes.LaneConfig{
+			{Name: lanes.MEVLaneName, MaxBlockSpace: math.LegacyZeroDec(), Disabled: true},
+			{Name: lanes.FreeLaneName, MaxBlockSpace: math.LegacyNewDecWithPrec(1, 1)},
+		},
+	})
+
+	config, found := registry.LaneConfig(ctx, lanes.FreeLaneName)
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), config.MaxBlockSpace)
+
+	_, found = registry.LaneConfig(ctx, lanes.DefaultLaneName)
+	require.False(t, found)
+
+	require.False(t, registry.IsEnabled(ctx, lanes.MEVLaneName))
+	require.True(t, registry.IsEnabled(ctx, lanes.FreeLaneName))
+	require.True(t, registry.IsEnabled(ctx, lanes.DefaultLaneName))
+
+	matchAll := func(ctx sdk.Context, tx sdk.Tx) bool { return true }
+	require.False(t, registry.MatchHandler(lanes.MEVLaneName, matchAll)(ctx, MockTx{}))
+	require.True(t, registry.MatchHandler(lanes.FreeLaneName, matchAll)(ctx, MockTx{}))
+	require.True(t, registry.MatchHandler(lanes.DefaultLaneName, matchAll)(ctx, MockTx{}))
+}
+
+func Test_RewardsAddressProvider(t *testing.T) {
+	ctx := sdk.NewContext(nil, types.Header{}, false, log.NewNopLogger())
+
+	provider := lanes.NewRewardsAddressProvider(authtypes.FeeCollectorName)
+	addr, err := provider.GetRewardsAddress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.NewModuleAddress(authtypes.FeeCollectorName), addr)
+
+	escrow := authtypes.NewModuleAddress(opchildtypes.MEVRewardsEscrowName)
+	provider = provider.WithMEVRewardsKeeper(MockMEVRewardsKeeper{addr: escrow})
+	addr, err = provider.GetRewardsAddress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, escrow, addr)
+}
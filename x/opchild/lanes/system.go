@@ -1,8 +1,13 @@
 package lanes
 
 import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	blockbase "github.com/skip-mev/block-sdk/v2/block/base"
 
@@ -15,21 +20,106 @@ import (
 func SystemLaneMatchHandler() blockbase.MatchHandler {
 	return func(ctx sdk.Context, tx sdk.Tx) bool {
 		for _, msg := range tx.GetMsgs() {
-			switch msg := msg.(type) {
-			case *clienttypes.MsgUpdateClient:
-			case *types.MsgUpdateOracle:
-			case *types.MsgRelayOracleData:
-			case *authz.MsgExec:
-				msgs, err := msg.GetMessages()
-				if err != nil || len(msgs) != 1 {
-					return false
-				}
-				switch msgs[0].(type) {
-				case *types.MsgUpdateOracle, *types.MsgRelayOracleData:
-				default:
-					return false
-				}
-			default:
+			if !isOracleSystemMsg(msg) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// isOracleSystemMsg returns true if the message is a client update or an oracle update,
+// including the oracle update wrapped by authz.MsgExec.
+func isOracleSystemMsg(msg sdk.Msg) bool {
+	switch msg := msg.(type) {
+	case *clienttypes.MsgUpdateClient:
+	case *types.MsgUpdateOracle:
+	case *types.MsgRelayOracleData:
+	case *authz.MsgExec:
+		msgs, err := msg.GetMessages()
+		if err != nil || len(msgs) != 1 {
+			return false
+		}
+		switch msgs[0].(type) {
+		case *types.MsgUpdateOracle, *types.MsgRelayOracleData:
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+type SystemLaneKeeper interface {
+	BridgeExecutors(ctx context.Context) ([]sdk.AccAddress, error)
+	OPinitChannel(ctx context.Context) (string, error)
+}
+
+// SystemLaneConfig builds the match handler for the system lane which, in addition to the
+// messages of SystemLaneMatchHandler, matches the bridge executor messages sent by
+// params.BridgeExecutors and the IBC packet messages on the opinit channel relayed by the bridge
+// executors or the known relayers. The number of messages of a type in a tx can be capped, so
+// the lane cannot be filled with a single tx.
+type SystemLaneConfig struct {
+	ac       address.Codec
+	keeper   SystemLaneKeeper
+	msgCaps  map[string]int
+	relayers []sdk.AccAddress
+}
+
+func NewSystemLaneConfig(ac address.Codec, keeper SystemLaneKeeper) SystemLaneConfig {
+	return SystemLaneConfig{
+		ac:      ac,
+		keeper:  keeper,
+		msgCaps: map[string]int{},
+	}
+}
+
+// WithRelayers allows the relayers, in addition to the bridge executors, to relay the IBC packet
+// messages on the opinit channel through the system lane. The packet messages relayed by the
+// others fall to the other lanes, so anyone cannot fill the system lane with the packets.
+func (c SystemLaneConfig) WithRelayers(relayers ...sdk.AccAddress) SystemLaneConfig {
+	c.relayers = append(append([]sdk.AccAddress{}, c.relayers...), relayers...)
+	return c
+}
+
+// WithMsgCap caps the number of the messages of the type url in a tx. A tx exceeding the cap
+// is not matched by the system lane. Zero removes the cap.
+func (c SystemLaneConfig) WithMsgCap(msgTypeURL string, maxMsgs int) SystemLaneConfig {
+	msgCaps := make(map[string]int, len(c.msgCaps)+1)
+	for typeURL, msgCap := range c.msgCaps {
+		msgCaps[typeURL] = msgCap
+	}
+
+	if maxMsgs == 0 {
+		delete(msgCaps, msgTypeURL)
+	} else {
+		msgCaps[msgTypeURL] = maxMsgs
+	}
+
+	c.msgCaps = msgCaps
+	return c
+}
+
+func (c SystemLaneConfig) MatchHandler() blockbase.MatchHandler {
+	return func(ctx sdk.Context, tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+
+		counts := make(map[string]int, len(msgs))
+		for _, msg := range msgs {
+			if !isOracleSystemMsg(msg) && !c.isBridgeSystemMsg(ctx, msg) {
+				return false
+			}
+
+			typeURL := sdk.MsgTypeURL(msg)
+			counts[typeURL]++
+			if msgCap, ok := c.msgCaps[typeURL]; ok && counts[typeURL] > msgCap {
 				return false
 			}
 		}
@@ -37,3 +127,75 @@ func SystemLaneMatchHandler() blockbase.MatchHandler {
 		return true
 	}
 }
+
+// isBridgeSystemMsg returns true if the message is a bridge executor message sent by a bridge
+// executor, or an IBC packet message on the opinit channel signed by a bridge executor or a
+// known relayer.
+func (c SystemLaneConfig) isBridgeSystemMsg(ctx sdk.Context, msg sdk.Msg) bool {
+	switch msg := msg.(type) {
+	case *types.MsgFinalizeTokenDeposit:
+		return c.isBridgeExecutor(ctx, msg.Sender)
+	case *types.MsgSetBridgeInfo:
+		return c.isBridgeExecutor(ctx, msg.Sender)
+	case *channeltypes.MsgRecvPacket:
+		return c.isOPinitChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel) && c.isRelayer(ctx, msg.Signer)
+	case *channeltypes.MsgAcknowledgement:
+		return c.isOPinitChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel) && c.isRelayer(ctx, msg.Signer)
+	case *channeltypes.MsgTimeout:
+		return c.isOPinitChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel) && c.isRelayer(ctx, msg.Signer)
+	default:
+		return false
+	}
+}
+
+func (c SystemLaneConfig) isBridgeExecutor(ctx sdk.Context, sender string) bool {
+	senderAddr, err := c.ac.StringToBytes(sender)
+	if err != nil {
+		return false
+	}
+
+	executors, err := c.keeper.BridgeExecutors(ctx)
+	if err != nil {
+		return false
+	}
+
+	for _, executor := range executors {
+		if bytes.Equal(executor, senderAddr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c SystemLaneConfig) isRelayer(ctx sdk.Context, signer string) bool {
+	if c.isBridgeExecutor(ctx, signer) {
+		return true
+	}
+
+	signerAddr, err := c.ac.StringToBytes(signer)
+	if err != nil {
+		return false
+	}
+
+	for _, relayer := range c.relayers {
+		if bytes.Equal(relayer, signerAddr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c SystemLaneConfig) isOPinitChannel(ctx sdk.Context, portID, channelID string) bool {
+	if portID != types.PortID {
+		return false
+	}
+
+	opinitChannel, err := c.keeper.OPinitChannel(ctx)
+	if err != nil || opinitChannel == "" {
+		return false
+	}
+
+	return channelID == opinitChannel
+}
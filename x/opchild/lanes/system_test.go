@@ -1,13 +1,16 @@
 package lanes_test
 
 import (
+	"context"
 	"testing"
 
 	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	"github.com/cometbft/cometbft/proto/tendermint/types"
 
@@ -91,3 +94,120 @@ func Test_SystemLaneMatchHandler(t *testing.T) {
 		},
 	}))
 }
+
+var _ lanes.SystemLaneKeeper = MockSystemLaneKeeper{}
+
+type MockSystemLaneKeeper struct {
+	executors     []sdk.AccAddress
+	opinitChannel string
+}
+
+// BridgeExecutors implements lanes.SystemLaneKeeper.
+func (m MockSystemLaneKeeper) BridgeExecutors(ctx context.Context) ([]sdk.AccAddress, error) {
+	return m.executors, nil
+}
+
+// OPinitChannel implements lanes.SystemLaneKeeper.
+func (m MockSystemLaneKeeper) OPinitChannel(ctx context.Context) (string, error) {
+	return m.opinitChannel, nil
+}
+
+func Test_SystemLaneConfig_MatchHandler(t *testing.T) {
+	ctx := sdk.NewContext(nil, types.Header{}, false, log.NewNopLogger())
+	ac := address.NewBech32Codec("init")
+
+	executor, err := ac.BytesToString([]byte{0, 1, 2, 3})
+	require.NoError(t, err)
+	other, err := ac.BytesToString([]byte{3, 4, 5, 6})
+	require.NoError(t, err)
+
+	config := lanes.NewSystemLaneConfig(ac, MockSystemLaneKeeper{
+		executors:     []sdk.AccAddress{{0, 1, 2, 3}},
+		opinitChannel: "channel-0",
+	})
+	handler := config.MatchHandler()
+
+	// the messages of the default system lane
+	require.True(t, handler(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&clienttypes.MsgUpdateClient{},
+			&opchildtypes.MsgRelayOracleData{},
+		},
+	}))
+
+	// bridge executor messages
+	require.True(t, handler(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&opchildtypes.MsgFinalizeTokenDeposit{Sender: executor},
+			&opchildtypes.MsgSetBridgeInfo{Sender: executor},
+		},
+	}))
+	require.False(t, handler(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&opchildtypes.MsgFinalizeTokenDeposit{Sender: other},
+		},
+	}))
+
+	// packet messages on the opinit channel relayed by a bridge executor
+	require.True(t, handler(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&clienttypes.MsgUpdateClient{},
+			&channeltypes.MsgRecvPacket{Packet: channeltypes.Packet{DestinationPort: opchildtypes.PortID, DestinationChannel: "channel-0"}, Signer: executor},
+			&channeltypes.MsgAcknowledgement{Packet: channeltypes.Packet{SourcePort: opchildtypes.PortID, SourceChannel: "channel-0"}, Signer: executor},
+			&channeltypes.MsgTimeout{Packet: channeltypes.Packet{SourcePort: opchildtypes.PortID, SourceChannel: "channel-0"}, Signer: executor},
+		},
+	}))
+	require.False(t, handler(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&channeltypes.MsgRecvPacket{Packet: channeltypes.Packet{DestinationPort: "transfer", DestinationChannel: "channel-0"}, Signer: executor},
+		},
+	}))
+	require.False(t, handler(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&channeltypes.MsgRecvPacket{Packet: channeltypes.Packet{DestinationPort: opchildtypes.PortID, DestinationChannel: "channel-1"}, Signer: executor},
+		},
+	}))
+
+	// packet messages relayed by an unknown relayer
+	for _, msg := range []sdk.Msg{
+		&channeltypes.MsgRecvPacket{Packet: channeltypes.Packet{DestinationPort: opchildtypes.PortID, DestinationChannel: "channel-0"}, Signer: other},
+		&channeltypes.MsgAcknowledgement{Packet: channeltypes.Packet{SourcePort: opchildtypes.PortID, SourceChannel: "channel-0"}, Signer: other},
+		&channeltypes.MsgTimeout{Packet: channeltypes.Packet{SourcePort: opchildtypes.PortID, SourceChannel: "channel-0"}, Signer: other},
+	} {
+		require.False(t, handler(ctx, MockTx{msgs: []sdk.Msg{msg}}))
+		require.True(t, config.WithRelayers(sdk.AccAddress{3, 4, 5, 6}).MatchHandler()(ctx, MockTx{msgs: []sdk.Msg{msg}}))
+	}
+
+	// mixed with a non-system message
+	require.False(t, handler(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&opchildtypes.MsgFinalizeTokenDeposit{Sender: executor},
+			&banktypes.MsgSend{},
+		},
+	}))
+
+	// no message
+	require.False(t, handler(ctx, MockTx{}))
+
+	// capped message type
+	handler = config.WithMsgCap(sdk.MsgTypeURL(&opchildtypes.MsgFinalizeTokenDeposit{}), 1).MatchHandler()
+	require.True(t, handler(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&opchildtypes.MsgFinalizeTokenDeposit{Sender: executor},
+		},
+	}))
+	require.False(t, handler(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&opchildtypes.MsgFinalizeTokenDeposit{Sender: executor},
+			&opchildtypes.MsgFinalizeTokenDeposit{Sender: executor},
+		},
+	}))
+
+	// the cap does not change the original config
+	require.True(t, config.MatchHandler()(ctx, MockTx{
+		msgs: []sdk.Msg{
+			&opchildtypes.MsgFinalizeTokenDeposit{Sender: executor},
+			&opchildtypes.MsgFinalizeTokenDeposit{Sender: executor},
+		},
+	}))
+}
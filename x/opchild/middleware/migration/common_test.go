@@ -16,11 +16,14 @@ import (
 
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
 var _ porttypes.IBCModule = &MockTransferApp{}
+var _ api.IBCModule = &MockTransferAppV2{}
 var _ BankKeeper = &MockBankKeeper{}
 var _ OPChildKeeper = &MockOPChildKeeper{}
 
@@ -241,6 +244,100 @@ func (im MockTransferApp) OnRecvPacket(
 	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 }
 
+type MockTransferAppV2 struct {
+	ac                address.Codec
+	bankKeeper        BankKeeper
+	onAcknowledgement func(ctx sdk.Context, payload channeltypesv2.Payload, acknowledgement []byte, relayer sdk.AccAddress) error
+	onTimeout         func(ctx sdk.Context, payload channeltypesv2.Payload, relayer sdk.AccAddress) error
+}
+
+// OnSendPacket implements the IBCModule interface
+func (im MockTransferAppV2) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface
+//
+// unescrow IBC token if the token is originated from the receiving chain (in test, it is same with minting)
+// mint IBC token if the token is not originated from the receiving chain
+func (im MockTransferAppV2) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	failure := channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return failure
+	}
+
+	transferAmount, ok := sdkmath.NewIntFromString(data.Token.Amount)
+	if !ok {
+		return failure
+	}
+
+	denom := data.Token.Denom
+	if denom.HasPrefix(payload.SourcePort, sourceClient) {
+		denom = transfertypes.NewDenom(denom.Base, denom.Trace[1:]...)
+	} else {
+		destHop := transfertypes.NewHop(payload.DestinationPort, destinationClient)
+		denom = transfertypes.NewDenom(denom.Base, append([]transfertypes.Hop{destHop}, denom.Trace...)...)
+	}
+
+	receiverAddr, err := im.ac.StringToBytes(data.Receiver)
+	if err != nil {
+		return failure
+	}
+	im.bankKeeper.(*MockBankKeeper).AddBalances(ctx, receiverAddr, sdk.NewCoins(sdk.NewCoin(denom.IBCDenom(), transferAmount)))
+
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: []byte{byte(1)},
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im MockTransferAppV2) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if im.onTimeout != nil {
+		return im.onTimeout(ctx, payload, relayer)
+	}
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im MockTransferAppV2) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if im.onAcknowledgement != nil {
+		return im.onAcknowledgement(ctx, payload, acknowledgement, relayer)
+	}
+	return nil
+}
+
 var keyCounter uint64
 
 // we need to make this deterministic (same every test run), as encoded address size and thus gas cost,
@@ -267,3 +364,13 @@ func buildPacketData(t *testing.T, denom, amount, sender, receiver, memo string)
 	require.NoError(t, err)
 	return data
 }
+
+func buildPayload(t *testing.T, denom, amount, sender, receiver, memo string) channeltypesv2.Payload {
+	return channeltypesv2.Payload{
+		SourcePort:      transfertypes.PortID,
+		DestinationPort: transfertypes.PortID,
+		Version:         transfertypes.V1,
+		Encoding:        transfertypes.EncodingJSON,
+		Value:           buildPacketData(t, denom, amount, sender, receiver, memo),
+	}
+}
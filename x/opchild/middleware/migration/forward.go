@@ -0,0 +1,51 @@
+package migration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+const (
+	// forwardMemoKey is the memo key of the packet-forward-middleware metadata.
+	forwardMemoKey = "forward"
+
+	// forwardModuleName is the module name used by packet-forward-middleware to derive the
+	// intermediate receiver; the typo is the upstream one.
+	forwardModuleName = "packetfowardmiddleware"
+)
+
+// isForwardPacket returns true if the packet is a packet-forward-middleware hop on this chain.
+//
+// When the middleware wraps packet-forward-middleware, the hop is detected by the forward memo.
+// When packet-forward-middleware wraps the middleware, the memo is cleared and the receiver is
+// overridden with the intermediate account, so the hop is detected by the receiver.
+func isForwardPacket(ac address.Codec, destChannel string, data transfertypes.FungibleTokenPacketData) bool {
+	var memo map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data.Memo), &memo); err == nil {
+		if _, ok := memo[forwardMemoKey]; ok {
+			return true
+		}
+	}
+
+	receiver, err := ac.StringToBytes(data.Receiver)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(receiver, forwardReceiver(destChannel, data.Sender))
+}
+
+// forwardReceiver returns the intermediate receiver of packet-forward-middleware for the packets
+// received on the channel from the sender.
+func forwardReceiver(channel, sender string) sdk.AccAddress {
+	hash := sdkaddress.Hash(forwardModuleName, []byte(fmt.Sprintf("%s/%s", channel, sender)))
+	return sdk.AccAddress(hash[:20])
+}
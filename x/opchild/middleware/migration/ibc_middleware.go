@@ -173,6 +173,12 @@ func (im IBCMiddleware) OnRecvPacket(
 		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
 	}
 
+	// if the packet is forwarded by packet-forward-middleware, the receiver is an intermediate
+	// account which sends the token out again, so keep the IBC token
+	if isForwardPacket(im.ac, packet.GetDestChannel(), data) {
+		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
 	// if the token is not registered for migration, do nothing
 	if hasMigration, err := im.opChildKeeper.HasIBCToL2DenomMap(ctx, ibcDenom); err != nil || !hasMigration {
 		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
@@ -193,29 +199,15 @@ func (im IBCMiddleware) OnRecvPacket(
 		return ack
 	}
 
-	// if the balance is not changed, do nothing
-	afterBalance := im.bankKeeper.GetBalance(ctx, receiver, ibcDenom)
-	if afterBalance.Amount.LTE(beforeBalance.Amount) {
-		return ack
-	}
-
-	// compute the difference
-	diff := afterBalance.Amount.Sub(beforeBalance.Amount)
-
 	// burn IBC token and mint L2 token
-	ibcCoin := sdk.NewCoin(ibcDenom, diff)
-	l2Coin, err := im.opChildKeeper.HandleMigratedTokenDeposit(ctx, receiver, ibcCoin, data.Memo)
-	if err != nil {
+	if err := migrateReceivedTokens(
+		ctx, im.bankKeeper, im.opChildKeeper,
+		EventTypeHandleMigratedTokenDeposit,
+		receiver, data.Receiver, beforeBalance, data.Memo,
+	); err != nil {
 		return newEmitErrorAcknowledgement(err)
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		EventTypeHandleMigratedTokenDeposit,
-		sdk.NewAttribute(AttributeKeyReceiver, data.Receiver),
-		sdk.NewAttribute(AttributeKeyIbcDenom, ibcDenom),
-		sdk.NewAttribute(AttributeKeyAmount, l2Coin.String()),
-	))
-
 	return ack
 }
 
@@ -258,30 +250,12 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
-	// if the balance is not changed, do nothing
-	afterBalance := im.bankKeeper.GetBalance(ctx, sender, ibcDenom)
-	if afterBalance.Amount.LTE(beforeBalance.Amount) {
-		return nil
-	}
-
-	// compute the difference
-	diff := afterBalance.Amount.Sub(beforeBalance.Amount)
-
 	// burn IBC token and mint L2 token
-	ibcCoin := sdk.NewCoin(ibcDenom, diff)
-	l2Coin, err := im.opChildKeeper.HandleMigratedTokenDeposit(ctx, sender, ibcCoin, "")
-	if err != nil {
-		return err
-	}
-
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
+	return migrateReceivedTokens(
+		ctx, im.bankKeeper, im.opChildKeeper,
 		EventTypeHandleMigratedTokenRefund,
-		sdk.NewAttribute(AttributeKeyReceiver, data.Sender),
-		sdk.NewAttribute(AttributeKeyIbcDenom, ibcDenom),
-		sdk.NewAttribute(AttributeKeyAmount, l2Coin.String()),
-	))
-
-	return nil
+		sender, data.Sender, beforeBalance, "",
+	)
 }
 
 // OnTimeoutPacket implements the IBCMiddleware interface
@@ -317,30 +291,12 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return err
 	}
 
-	// if the balance is not changed, do nothing
-	afterBalance := im.bankKeeper.GetBalance(ctx, sender, ibcDenom)
-	if afterBalance.Amount.LTE(beforeBalance.Amount) {
-		return nil
-	}
-
-	// compute the difference
-	diff := afterBalance.Amount.Sub(beforeBalance.Amount)
-
 	// burn IBC token and mint L2 token
-	ibcCoin := sdk.NewCoin(ibcDenom, diff)
-	l2Coin, err := im.opChildKeeper.HandleMigratedTokenDeposit(ctx, sender, ibcCoin, "")
-	if err != nil {
-		return err
-	}
-
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
+	return migrateReceivedTokens(
+		ctx, im.bankKeeper, im.opChildKeeper,
 		EventTypeHandleMigratedTokenRefund,
-		sdk.NewAttribute(AttributeKeyReceiver, data.Sender),
-		sdk.NewAttribute(AttributeKeyIbcDenom, ibcDenom),
-		sdk.NewAttribute(AttributeKeyAmount, l2Coin.String()),
-	))
-
-	return nil
+		sender, data.Sender, beforeBalance, "",
+	)
 }
 
 // lookupPacket checks if the packet is a fungible token transfer packet and not originated from the
@@ -351,24 +307,75 @@ func lookupPacket(packet channeltypes.Packet, receive bool) (data transfertypes.
 		return data, "", false
 	}
 
-	denom := transfertypes.ExtractDenomFromPath(data.Denom)
+	ibcDenom, needCheck = lookupDenom(
+		transfertypes.ExtractDenomFromPath(data.Denom),
+		packet.GetSourcePort(), packet.GetSourceChannel(),
+		packet.GetDestPort(), packet.GetDestChannel(),
+		receive,
+	)
+
+	return data, ibcDenom, needCheck
+}
+
+// lookupDenom returns the IBC denom of the transferred token on this chain, if the token is not originated
+// from the receiving chain (if receive=true) or sending chain (if receive=false). The channels are the client
+// ids for IBC v2 packets.
+func lookupDenom(denom transfertypes.Denom, sourcePort, sourceChannel, destPort, destChannel string, receive bool) (ibcDenom string, needCheck bool) {
 	// if the token is originated from the receiving chain, do nothing
-	if receive && denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
-		return data, "", false
+	if receive && denom.HasPrefix(sourcePort, sourceChannel) {
+		return "", false
 	}
 
 	// if the token is originated from the sending chain, do nothing
-	if !receive && !denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
-		return data, "", false
+	if !receive && !denom.HasPrefix(sourcePort, sourceChannel) {
+		return "", false
 	}
 
 	// compute the prefixed ibc denom
 	if receive {
-		destHop := transfertypes.NewHop(packet.GetDestPort(), packet.GetDestChannel())
+		destHop := transfertypes.NewHop(destPort, destChannel)
 		denom = transfertypes.NewDenom(denom.Base, append([]transfertypes.Hop{destHop}, denom.Trace...)...)
 	}
 
-	return data, denom.IBCDenom(), true
+	return denom.IBCDenom(), true
+}
+
+// migrateReceivedTokens converts the increase of the IBC token balance of the account since the before
+// balance into the L2 token, and emits the event of the given type.
+func migrateReceivedTokens(
+	ctx sdk.Context,
+	bankKeeper BankKeeper,
+	opChildKeeper OPChildKeeper,
+	eventType string,
+	addr sdk.AccAddress,
+	addrStr string,
+	beforeBalance sdk.Coin,
+	memo string,
+) error {
+	// if the balance is not changed, do nothing
+	afterBalance := bankKeeper.GetBalance(ctx, addr, beforeBalance.Denom)
+	if afterBalance.Amount.LTE(beforeBalance.Amount) {
+		return nil
+	}
+
+	// compute the difference
+	diff := afterBalance.Amount.Sub(beforeBalance.Amount)
+
+	// burn IBC token and mint L2 token
+	ibcCoin := sdk.NewCoin(beforeBalance.Denom, diff)
+	l2Coin, err := opChildKeeper.HandleMigratedTokenDeposit(ctx, addr, ibcCoin, memo)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(AttributeKeyReceiver, addrStr),
+		sdk.NewAttribute(AttributeKeyIbcDenom, ibcCoin.Denom),
+		sdk.NewAttribute(AttributeKeyAmount, l2Coin.String()),
+	))
+
+	return nil
 }
 
 // newEmitErrorAcknowledgement creates a new error acknowledgement after having emitted an event with the
@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 )
 
 func TestIBCMiddleware_OnRecvPacket(t *testing.T) {
@@ -388,3 +390,258 @@ func TestIBCMiddleware_OnTimeoutPacket_SenderChainSource_NoMigration(t *testing.
 		require.NotEqual(t, EventTypeHandleMigratedTokenRefund, evt.Type)
 	}
 }
+
+func TestIBCMiddleware_OnRecvPacket_ForwardPacket_NoMigration(t *testing.T) {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	_, _, relayer := keyPubAddr()
+	_, _, senderAcc := keyPubAddr()
+	_, _, receiverAcc := keyPubAddr()
+	cdc := codec.NewProtoCodec(nil)
+	ac := address.NewBech32Codec("init")
+
+	senderStr, err := ac.BytesToString(senderAcc.Bytes())
+	require.NoError(t, err)
+	receiverStr, err := ac.BytesToString(receiverAcc.Bytes())
+	require.NoError(t, err)
+
+	bankKeeper := &MockBankKeeper{
+		ac:       ac,
+		balances: make(map[string]sdk.Coins),
+	}
+	opchildKeeper := &MockOPChildKeeper{
+		bankKeeper:      bankKeeper,
+		ibcToL2DenomMap: make(map[string]string),
+	}
+	app := MockTransferApp{
+		ac:         ac,
+		bankKeeper: bankKeeper,
+	}
+
+	middleware := NewIBCMiddleware(
+		ac,
+		cdc,
+		app,
+		nil,
+		bankKeeper,
+		opchildKeeper,
+	)
+
+	ibcDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", "uinit")).IBCDenom()
+	opchildKeeper.ibcToL2DenomMap[ibcDenom] = "uinit"
+
+	// case 1. packet-forward-middleware wraps the middleware; the memo is cleared and
+	// the receiver is overridden with the intermediate account
+	intermediateAcc := forwardReceiver("channel-0", senderStr)
+	intermediateStr, err := ac.BytesToString(intermediateAcc)
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-1",
+		Data:               buildPacketData(t, "uinit", "100", senderStr, intermediateStr, ""),
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+	}
+
+	ack := middleware.OnRecvPacket(ctx, transfertypes.V1, packet, relayer)
+	require.True(t, ack.Success())
+
+	// the IBC token is kept to be forwarded
+	require.Equal(t, sdk.NewCoin(ibcDenom, sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, intermediateAcc, ibcDenom))
+	require.True(t, bankKeeper.GetBalance(ctx, intermediateAcc, "uinit").Amount.IsZero())
+
+	// case 2. the middleware wraps packet-forward-middleware; the memo has the forward metadata
+	memo := `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-2"}}`
+	packet.Data = buildPacketData(t, "uinit", "100", senderStr, receiverStr, memo)
+
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, packet, relayer)
+	require.True(t, ack.Success())
+
+	require.Equal(t, sdk.NewCoin(ibcDenom, sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, receiverAcc, ibcDenom))
+	require.True(t, bankKeeper.GetBalance(ctx, receiverAcc, "uinit").Amount.IsZero())
+
+	for _, evt := range ctx.EventManager().Events() {
+		require.NotEqual(t, EventTypeHandleMigratedTokenDeposit, evt.Type)
+	}
+
+	// case 3. the final hop is converted as usual
+	packet.Data = buildPacketData(t, "uinit", "100", senderStr, receiverStr, `{"other":{}}`)
+
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, packet, relayer)
+	require.True(t, ack.Success())
+
+	require.Equal(t, sdk.NewCoin(ibcDenom, sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, receiverAcc, ibcDenom))
+	require.Equal(t, sdk.NewCoin("uinit", sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, receiverAcc, "uinit"))
+}
+
+func TestIBCMiddlewareV2_OnRecvPacket(t *testing.T) {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	_, _, relayer := keyPubAddr()
+	_, _, accAddr := keyPubAddr()
+	ac := address.NewBech32Codec("init")
+	addr, err := ac.BytesToString(accAddr.Bytes())
+	require.NoError(t, err)
+
+	bankKeeper := &MockBankKeeper{
+		ac:       ac,
+		balances: make(map[string]sdk.Coins),
+	}
+	opchildKeeper := &MockOPChildKeeper{
+		bankKeeper:      bankKeeper,
+		ibcToL2DenomMap: make(map[string]string),
+	}
+	app := MockTransferAppV2{
+		ac:         ac,
+		bankKeeper: bankKeeper,
+	}
+
+	middleware := NewIBCMiddlewareV2(ac, app, bankKeeper, opchildKeeper)
+
+	// case 1. receiving chain is source chain case
+	payload := buildPayload(t, "transfer/07-tendermint-0/uinit", "100", addr, addr, "")
+	res := middleware.OnRecvPacket(ctx, "07-tendermint-0", "07-tendermint-1", 1, payload, relayer)
+	require.Equal(t, channeltypesv2.PacketStatus_Success, res.Status)
+	require.Equal(t, sdk.NewCoin("uinit", sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, accAddr, "uinit"))
+
+	// case 2. non-migrated asset transfer
+	ibcDenom := transfertypes.NewDenom("uinit", transfertypes.NewHop("transfer", "07-tendermint-1")).IBCDenom()
+	payload = buildPayload(t, "uinit", "100", addr, addr, "")
+	res = middleware.OnRecvPacket(ctx, "07-tendermint-0", "07-tendermint-1", 2, payload, relayer)
+	require.Equal(t, channeltypesv2.PacketStatus_Success, res.Status)
+	require.Equal(t, sdk.NewCoin(ibcDenom, sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, accAddr, ibcDenom))
+
+	// case 3. migrated asset transfer
+	opchildKeeper.ibcToL2DenomMap[ibcDenom] = "uinit"
+	res = middleware.OnRecvPacket(ctx, "07-tendermint-0", "07-tendermint-1", 3, payload, relayer)
+	require.Equal(t, channeltypesv2.PacketStatus_Success, res.Status)
+
+	// check balance increased; we transferred IBC denom but received uinit due to the migration
+	require.Equal(t, sdk.NewCoin("uinit", sdkmath.NewInt(200)), bankKeeper.GetBalance(ctx, accAddr, "uinit"))
+	require.Equal(t, sdk.NewCoin(ibcDenom, sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, accAddr, ibcDenom))
+
+	found := false
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == EventTypeHandleMigratedTokenDeposit {
+			found = true
+		}
+	}
+	require.True(t, found, "expected EventTypeHandleMigratedTokenDeposit event")
+
+	// case 4. invalid receiver fails the packet
+	payload = buildPayload(t, "uinit", "100", addr, "invalid", "")
+	res = middleware.OnRecvPacket(ctx, "07-tendermint-0", "07-tendermint-1", 4, payload, relayer)
+	require.Equal(t, channeltypesv2.PacketStatus_Failure, res.Status)
+}
+
+func TestIBCMiddlewareV2_OnAcknowledgementPacket_ErrorRefundsMigratedTokens(t *testing.T) {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	_, _, relayer := keyPubAddr()
+	_, _, senderAcc := keyPubAddr()
+	_, _, receiverAcc := keyPubAddr()
+	ac := address.NewBech32Codec("init")
+
+	senderStr, err := ac.BytesToString(senderAcc.Bytes())
+	require.NoError(t, err)
+	receiverStr, err := ac.BytesToString(receiverAcc.Bytes())
+	require.NoError(t, err)
+
+	bankKeeper := &MockBankKeeper{
+		ac:       ac,
+		balances: make(map[string]sdk.Coins),
+	}
+	opchildKeeper := &MockOPChildKeeper{
+		bankKeeper:      bankKeeper,
+		ibcToL2DenomMap: make(map[string]string),
+	}
+	app := MockTransferAppV2{
+		ac:         ac,
+		bankKeeper: bankKeeper,
+	}
+
+	baseDenom := "uinit"
+	fullDenom := "transfer/07-tendermint-0/" + baseDenom
+	ibcDenom := transfertypes.ExtractDenomFromPath(fullDenom).IBCDenom()
+	opchildKeeper.ibcToL2DenomMap[ibcDenom] = baseDenom
+
+	app.onAcknowledgement = func(ctx sdk.Context, payload channeltypesv2.Payload, acknowledgement []byte, relayer sdk.AccAddress) error {
+		if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+			bankKeeper.AddBalances(ctx, senderAcc, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(100))))
+		}
+		return nil
+	}
+
+	middleware := NewIBCMiddlewareV2(ac, app, bankKeeper, opchildKeeper)
+	payload := buildPayload(t, fullDenom, "100", senderStr, receiverStr, "")
+
+	// success ack does nothing
+	err = middleware.OnAcknowledgementPacket(ctx, "07-tendermint-0", "07-tendermint-1", 1, []byte{byte(1)}, payload, relayer)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetBalance(ctx, senderAcc, baseDenom).Amount.IsZero())
+
+	// error ack refunds the migrated token
+	err = middleware.OnAcknowledgementPacket(ctx, "07-tendermint-0", "07-tendermint-1", 1, channeltypesv2.ErrorAcknowledgement[:], payload, relayer)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewCoin(baseDenom, sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, senderAcc, baseDenom))
+	require.True(t, bankKeeper.GetBalance(ctx, senderAcc, ibcDenom).Amount.IsZero())
+
+	found := false
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == EventTypeHandleMigratedTokenRefund {
+			found = true
+		}
+	}
+	require.True(t, found, "expected EventTypeHandleMigratedTokenRefund event")
+}
+
+func TestIBCMiddlewareV2_OnTimeoutPacket_RefundsMigratedTokens(t *testing.T) {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	_, _, relayer := keyPubAddr()
+	_, _, senderAcc := keyPubAddr()
+	_, _, receiverAcc := keyPubAddr()
+	ac := address.NewBech32Codec("init")
+
+	senderStr, err := ac.BytesToString(senderAcc.Bytes())
+	require.NoError(t, err)
+	receiverStr, err := ac.BytesToString(receiverAcc.Bytes())
+	require.NoError(t, err)
+
+	bankKeeper := &MockBankKeeper{
+		ac:       ac,
+		balances: make(map[string]sdk.Coins),
+	}
+	opchildKeeper := &MockOPChildKeeper{
+		bankKeeper:      bankKeeper,
+		ibcToL2DenomMap: make(map[string]string),
+	}
+	app := MockTransferAppV2{
+		ac:         ac,
+		bankKeeper: bankKeeper,
+	}
+
+	baseDenom := "uinit"
+	fullDenom := "transfer/07-tendermint-0/" + baseDenom
+	ibcDenom := transfertypes.ExtractDenomFromPath(fullDenom).IBCDenom()
+	opchildKeeper.ibcToL2DenomMap[ibcDenom] = baseDenom
+
+	app.onTimeout = func(ctx sdk.Context, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+		bankKeeper.AddBalances(ctx, senderAcc, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(100))))
+		return nil
+	}
+
+	middleware := NewIBCMiddlewareV2(ac, app, bankKeeper, opchildKeeper)
+
+	// the token originated from the sending chain is not migrated
+	payload := buildPayload(t, baseDenom, "100", senderStr, receiverStr, "")
+	err = middleware.OnTimeoutPacket(ctx, "07-tendermint-0", "07-tendermint-1", 1, payload, relayer)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetBalance(ctx, senderAcc, baseDenom).Amount.IsZero())
+
+	// the refunded IBC token is migrated
+	payload = buildPayload(t, fullDenom, "100", senderStr, receiverStr, "")
+	err = middleware.OnTimeoutPacket(ctx, "07-tendermint-0", "07-tendermint-1", 2, payload, relayer)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewCoin(baseDenom, sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, senderAcc, baseDenom))
+	require.Equal(t, sdk.NewCoin(ibcDenom, sdkmath.NewInt(100)), bankKeeper.GetBalance(ctx, senderAcc, ibcDenom))
+}
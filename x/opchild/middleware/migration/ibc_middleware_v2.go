@@ -0,0 +1,220 @@
+package migration
+
+import (
+	"bytes"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+// Interface assertions to ensure IBCMiddlewareV2 implements required interfaces
+var _ api.IBCModule = &IBCMiddlewareV2{}
+
+// IBCMiddlewareV2 wraps an underlying IBC v2 module and intercepts fungible token
+// transfer payloads in order to migrate legacy OP-tokens into their IBC-token equivalent
+// when a registered denom is received, acked, or timed out.
+type IBCMiddlewareV2 struct {
+	ac address.Codec
+
+	// app is the underlying IBC v2 module that handles standard IBC operations
+	app api.IBCModule
+	// bankKeeper is the keeper for the bank module
+	bankKeeper BankKeeper
+	// opChildKeeper is the keeper for the opchild module
+	opChildKeeper OPChildKeeper
+}
+
+// NewIBCMiddlewareV2 creates a new IBCMiddlewareV2 given the keeper and underlying application
+func NewIBCMiddlewareV2(
+	ac address.Codec,
+	app api.IBCModule,
+	bankKeeper BankKeeper,
+	opChildKeeper OPChildKeeper,
+) IBCMiddlewareV2 {
+	return IBCMiddlewareV2{
+		ac:            ac,
+		app:           app,
+		bankKeeper:    bankKeeper,
+		opChildKeeper: opChildKeeper,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface
+func (im IBCMiddlewareV2) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket implements the IBCModule interface
+func (im IBCMiddlewareV2) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	// if it is not a transfer payload or receiver chain is source, then execute inner app
+	// without any further checks
+	data, ibcDenom, ok := lookupPayload(payload, sourceClient, destinationClient, true)
+	if !ok {
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	// if the token is not registered for migration, do nothing
+	if hasMigration, err := im.opChildKeeper.HasIBCToL2DenomMap(ctx, ibcDenom); err != nil || !hasMigration {
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	// get the receiver address
+	receiver, err := im.ac.StringToBytes(data.Receiver)
+	if err != nil {
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	// get the before balance
+	beforeBalance := im.bankKeeper.GetBalance(ctx, receiver, ibcDenom)
+
+	// call the underlying IBC module
+	res := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if res.Status != channeltypesv2.PacketStatus_Success {
+		return res
+	}
+
+	// burn IBC token and mint L2 token
+	if err := migrateReceivedTokens(
+		ctx, im.bankKeeper, im.opChildKeeper,
+		EventTypeHandleMigratedTokenDeposit,
+		receiver, data.Receiver, beforeBalance, data.Memo,
+	); err != nil {
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	return res
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCMiddlewareV2) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	// if it is not an error ack, just pass through
+	if !bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+	}
+
+	// if it is not a transfer payload or sender chain is source, then execute inner app
+	// without any further checks
+	data, ibcDenom, ok := lookupPayload(payload, sourceClient, destinationClient, false)
+	if !ok {
+		return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+	}
+
+	// if the token is not registered for migration, just pass through
+	if hasMigration, err := im.opChildKeeper.HasIBCToL2DenomMap(ctx, ibcDenom); err != nil || !hasMigration {
+		return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+	}
+
+	// get the sender address
+	sender, err := im.ac.StringToBytes(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	// get the before balance
+	beforeBalance := im.bankKeeper.GetBalance(ctx, sender, ibcDenom)
+
+	// call the underlying IBC module
+	if err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+
+	// burn IBC token and mint L2 token
+	return migrateReceivedTokens(
+		ctx, im.bankKeeper, im.opChildKeeper,
+		EventTypeHandleMigratedTokenRefund,
+		sender, data.Sender, beforeBalance, "",
+	)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCMiddlewareV2) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	// if it is not a transfer payload or sender chain is source, then execute inner app
+	// without any further checks
+	data, ibcDenom, ok := lookupPayload(payload, sourceClient, destinationClient, false)
+	if !ok {
+		return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	// if the token is not registered for migration, just pass through
+	if hasMigration, err := im.opChildKeeper.HasIBCToL2DenomMap(ctx, ibcDenom); err != nil || !hasMigration {
+		return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	// get the sender address
+	sender, err := im.ac.StringToBytes(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	// get the before balance
+	beforeBalance := im.bankKeeper.GetBalance(ctx, sender, ibcDenom)
+
+	// call the underlying IBC module
+	if err := im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer); err != nil {
+		return err
+	}
+
+	// burn IBC token and mint L2 token
+	return migrateReceivedTokens(
+		ctx, im.bankKeeper, im.opChildKeeper,
+		EventTypeHandleMigratedTokenRefund,
+		sender, data.Sender, beforeBalance, "",
+	)
+}
+
+// lookupPayload checks if the payload is a fungible token transfer payload and not originated from the
+// receiving chain (if receive=true) or sending chain (if receive=false). If so, it computes the IBC denom
+// and returns it along with the parsed payload data. Otherwise, it returns ok=false.
+func lookupPayload(
+	payload channeltypesv2.Payload,
+	sourceClient, destinationClient string,
+	receive bool,
+) (data transfertypes.InternalTransferRepresentation, ibcDenom string, needCheck bool) {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return data, "", false
+	}
+
+	ibcDenom, needCheck = lookupDenom(
+		data.Token.Denom,
+		payload.SourcePort, sourceClient,
+		payload.DestinationPort, destinationClient,
+		receive,
+	)
+
+	return data, ibcDenom, needCheck
+}
@@ -29,7 +29,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSpendFeePool{}, "opchild/MsgSpendFeePool")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterMigrationInfo{}, "opchild/MsgRegisterMigrationInfo")
 	legacy.RegisterAminoMsg(cdc, &MsgMigrateToken{}, "opchild/MsgMigrateToken")
+	legacy.RegisterAminoMsg(cdc, &MsgUnmigrateToken{}, "opchild/MsgUnmigrateToken")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMigrationToggles{}, "opchild/MsgUpdateMigrationToggles")
 	legacy.RegisterAminoMsg(cdc, &MsgRelayOracleData{}, "opchild/MsgRelayOracleData")
+	legacy.RegisterAminoMsg(cdc, &MsgFinalizeTokenDepositWithProof{}, "opchild/MsgFinalizeTokenDepositWithProof")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleExecutorChange{}, "opchild/MsgScheduleExecutorChange")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelExecutorChange{}, "opchild/MsgCancelExecutorChange")
+	legacy.RegisterAminoMsg(cdc, &MsgAddSequencer{}, "opchild/MsgAddSequencer")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveSequencer{}, "opchild/MsgRemoveSequencer")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterSponsorship{}, "opchild/MsgRegisterSponsorship")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveSponsorship{}, "opchild/MsgRemoveSponsorship")
 
 	cdc.RegisterConcrete(Params{}, "opchild/Params", nil)
 }
@@ -53,7 +62,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSpendFeePool{},
 		&MsgRegisterMigrationInfo{},
 		&MsgMigrateToken{},
+		&MsgUnmigrateToken{},
+		&MsgUpdateMigrationToggles{},
 		&MsgRelayOracleData{},
+		&MsgFinalizeTokenDepositWithProof{},
+		&MsgScheduleExecutorChange{},
+		&MsgCancelExecutorChange{},
+		&MsgAddSequencer{},
+		&MsgRemoveSequencer{},
+		&MsgRegisterSponsorship{},
+		&MsgRemoveSponsorship{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
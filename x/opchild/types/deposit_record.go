@@ -0,0 +1,6 @@
+package types
+
+// IsFailed returns true if the deposit or the hook of the deposit is failed.
+func (record DepositRecord) IsFailed() bool {
+	return !record.Success || (record.HookExecuted && !record.HookSuccess)
+}
@@ -37,6 +37,23 @@ var (
 	ErrIBCKeepersNotInitialized        = errorsmod.Register(ModuleName, 31, "IBC keepers not initialized")
 	ErrIBCKeepersAlreadySet            = errorsmod.Register(ModuleName, 32, "IBC keepers already set, can only be called once")
 	ErrIBCKeepersNonNil                = errorsmod.Register(ModuleName, 33, "All IBC keepers must be non-nil")
+	ErrInvalidExecutorThreshold        = errorsmod.Register(ModuleName, 34, "invalid bridge executor threshold")
+	ErrInvalidDepositProof             = errorsmod.Register(ModuleName, 35, "invalid deposit proof")
+	ErrExecutorChangePlanNotFound      = errorsmod.Register(ModuleName, 36, "executor change plan not found")
+	ErrInvalidSequencerWeight          = errorsmod.Register(ModuleName, 37, "invalid sequencer weight")
+	ErrSequencerExists                 = errorsmod.Register(ModuleName, 38, "sequencer already exists")
+	ErrSequencerNotFound               = errorsmod.Register(ModuleName, 39, "sequencer not found")
+	ErrEmptySequencerSet               = errorsmod.Register(ModuleName, 40, "sequencer set cannot be empty")
+	ErrInvalidMaxSequencers            = errorsmod.Register(ModuleName, 41, "invalid max sequencers")
+	ErrWithdrawalNotFound              = errorsmod.Register(ModuleName, 42, "withdrawal not found")
+	ErrBlockHashNotFound               = errorsmod.Register(ModuleName, 43, "block hash not found")
+	ErrInvalidFeeMarket                = errorsmod.Register(ModuleName, 44, "invalid fee market")
+	ErrInvalidSponsorship              = errorsmod.Register(ModuleName, 45, "invalid sponsorship")
+	ErrSponsorshipNotFound             = errorsmod.Register(ModuleName, 46, "sponsorship not found")
+	ErrInvalidFeeSplit                 = errorsmod.Register(ModuleName, 47, "invalid fee split")
+	ErrInvalidLaneConfig               = errorsmod.Register(ModuleName, 48, "invalid lane config")
+	ErrInvalidMEVRewards               = errorsmod.Register(ModuleName, 49, "invalid mev rewards")
+	ErrInvalidOPinitChannel            = errorsmod.Register(ModuleName, 50, "invalid opinit channel")
 
 	// AnteHandler error
 	ErrRedundantTx = errorsmod.Register(ModuleName, 29, "tx messages are all redundant")
@@ -19,10 +19,31 @@ const (
 	EventTypeSetBridgeInfo           = "set_bridge_info"
 	EventTypeRegisterMigrationInfo   = "register_migration_info"
 	EventTypeMigrateToken            = "migrate_token"
+	EventTypeUnmigrateToken          = "unmigrate_token"
+	EventTypeUpdateMigrationToggles  = "update_migration_toggles"
+	EventTypeAutoMigrateToken        = "auto_migrate_token"
 	EventTypeAttestorSetUpdate       = "attestor_set_update"
 	EventTypeOracleDataRelay         = "oracle_data_relay"
+	EventTypeOraclePriceUpdate       = "oracle_price_update_packet"
+	EventTypeL2StatusReportSent      = "l2_status_report_sent"
 	EventTypePacket                  = "opchild_packet"
 	EventTypeTimeout                 = "timeout"
+	EventTypePendingTokenDeposit     = "pending_token_deposit"
+	EventTypeEvictPendingDeposit     = "evict_pending_deposit"
+	EventTypeBridgeExecutorVote      = "bridge_executor_vote"
+	EventTypeScheduleExecutorChange  = "schedule_executor_change"
+	EventTypeCancelExecutorChange    = "cancel_executor_change"
+	EventTypeChangeExecutor          = "change_executor"
+	EventTypeAddSequencer            = "add_sequencer"
+	EventTypeRemoveSequencer         = "remove_sequencer"
+	EventTypeRotateSequencers        = "rotate_sequencers"
+	EventTypeReceiveForcedTx         = "receive_forced_tx"
+	EventTypeExecuteForcedTx         = "execute_forced_tx"
+	EventTypeUpdateBaseFee           = "update_base_fee"
+	EventTypeRegisterSponsorship     = "register_sponsorship"
+	EventTypeRemoveSponsorship       = "remove_sponsorship"
+	EventTypeConsumeSponsorship      = "consume_sponsorship"
+	EventTypeDistributeFees          = "distribute_fees"
 
 	AttributeKeySender          = "sender"
 	AttributeKeyBridgeId        = "bridge_id"
@@ -54,4 +75,29 @@ const (
 	AttributeKeyL1BlockHeight   = "l1_block_height"
 	AttributeKeyAttestorSetSize = "attestor_set_size"
 	AttributeKeyNumCurrencyPair = "num_currency_pair"
+	AttributeKeyL2BlockHeight   = "l2_block_height"
+	AttributeKeyNumValidators   = "num_validators"
+	AttributeKeyVoter           = "voter"
+	AttributeKeyPayloadHash     = "payload_hash"
+	AttributeKeyVotes           = "votes"
+	AttributeKeyThreshold       = "threshold"
+	AttributeKeyMsgType         = "msg_type"
+	AttributeKeyProposalId      = "proposal_id"
+	AttributeKeyNextExecutors   = "next_executors"
+	AttributeKeyWeight          = "weight"
+	AttributeKeySequencers      = "sequencers"
+	AttributeKeyForcedTxSeq     = "forced_tx_sequence"
+	AttributeKeyUnmigratedCoin  = "unmigrated_coin"
+	AttributeKeyUnmigration     = "unmigration_enabled"
+	AttributeKeyMigrationPaused = "migration_paused"
+	AttributeKeyAutoMigrate     = "auto_migrate"
+	AttributeKeyNumAccounts     = "num_accounts"
+	AttributeKeyDone            = "done"
+	AttributeKeyGasUsed         = "gas_used"
+	AttributeKeyBaseFee         = "base_fee_multiplier"
+	AttributeKeyAccount         = "account"
+	AttributeKeyGasBudget       = "gas_budget"
+	AttributeKeyPeriod          = "period"
+	AttributeKeyAccountGasLimit = "account_gas_limit"
+	AttributeKeyFeePayer        = "fee_payer"
 )
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs basic validation of the executor change plan.
+func (plan ExecutorChangePlan) Validate(ac, vc address.Codec) error {
+	if plan.ProposalID == 0 {
+		return errorsmod.Wrap(ErrInvalidExecutorChangePlan, "invalid proposal id")
+	}
+
+	if plan.Height == 0 {
+		return errorsmod.Wrap(ErrInvalidExecutorChangePlan, "invalid height")
+	}
+
+	if _, err := vc.StringToBytes(plan.NextValidator.OperatorAddress); err != nil {
+		return err
+	}
+
+	for _, nextExecutor := range plan.NextExecutors {
+		if _, err := ac.StringToBytes(nextExecutor); err != nil {
+			return err
+		}
+	}
+
+	if plan.NextValidator.ConsensusPubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"crypto/sha256"
+)
+
+// PayloadHash returns the hash of the deposit payload excluding the sender, which is used to
+// match the votes of the bridge executors.
+func (msg MsgFinalizeTokenDeposit) PayloadHash() ([]byte, error) {
+	msg.Sender = ""
+	bz, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// PayloadHash returns the hash of the bridge info payload excluding the sender, which is used
+// to match the votes of the bridge executors.
+func (msg MsgSetBridgeInfo) PayloadHash() ([]byte, error) {
+	msg.Sender = ""
+	bz, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// Validate performs basic validation of the fee denom oracle.
+func (o FeeDenomOracle) Validate() error {
+	if err := sdk.ValidateDenom(o.Denom); err != nil {
+		return err
+	}
+
+	if _, err := connecttypes.CurrencyPairFromString(o.CurrencyPair); err != nil {
+		return ErrInvalidFeeMarket.Wrapf("invalid currency pair %s of %s: %s", o.CurrencyPair, o.Denom, err)
+	}
+
+	// bound the power of ten the oracle price is divided by
+	if uint64(o.Decimals) > marketmaptypes.DefaultMaxDecimals {
+		return ErrInvalidFeeMarket.Wrapf("decimals too large: %d (max %d)", o.Decimals, marketmaptypes.DefaultMaxDecimals)
+	}
+
+	return nil
+}
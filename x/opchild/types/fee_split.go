@@ -0,0 +1,57 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+)
+
+const (
+	// FeeRecipientSequencers splits the share among the operators of the sequencers.
+	FeeRecipientSequencers = "sequencers"
+	// FeeRecipientAttestors splits the share among the operators of the attestors.
+	FeeRecipientAttestors = "attestors"
+	// FeeRecipientBurn burns the share.
+	FeeRecipientBurn = "burn"
+)
+
+// Validate performs basic validation of the fee split.
+func (s FeeSplit) Validate(ac address.Codec) error {
+	switch s.Recipient {
+	case FeeRecipientSequencers, FeeRecipientAttestors, FeeRecipientBurn:
+	default:
+		if _, err := ac.StringToBytes(s.Recipient); err != nil {
+			return ErrInvalidFeeSplit.Wrapf("invalid recipient %s: %s", s.Recipient, err)
+		}
+	}
+
+	if s.Ratio.IsNil() || !s.Ratio.IsPositive() || s.Ratio.GT(math.LegacyOneDec()) {
+		return ErrInvalidFeeSplit.Wrapf("invalid ratio %s of %s", s.Ratio, s.Recipient)
+	}
+
+	return nil
+}
+
+// ValidateFeeSplits checks the fee splits have distinct recipients and their ratios sum up to
+// at most one.
+func ValidateFeeSplits(ac address.Codec, splits []FeeSplit) error {
+	total := math.LegacyZeroDec()
+	recipients := make(map[string]bool, len(splits))
+	for _, split := range splits {
+		if err := split.Validate(ac); err != nil {
+			return err
+		}
+
+		if recipients[split.Recipient] {
+			return ErrInvalidFeeSplit.Wrapf("duplicate recipient %s", split.Recipient)
+		}
+		recipients[split.Recipient] = true
+
+		total = total.Add(split.Ratio)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return ErrInvalidFeeSplit.Wrapf("total ratio %s exceeds one", total)
+	}
+
+	return nil
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 const (
@@ -18,34 +21,61 @@ const (
 // NewGenesisState creates a new GenesisState instance
 func NewGenesisState(params Params, validators []Validator, bridgeInfo *BridgeInfo, migrationInfos []MigrationInfo) *GenesisState {
 	return &GenesisState{
-		Params:              params,
-		LastValidatorPowers: []LastValidatorPower{},
-		Validators:          validators,
-		Exported:            false,
-		BridgeInfo:          bridgeInfo,
-		DenomPairs:          []DenomPair{},
-		MigrationInfos:      migrationInfos,
+		Params:                params,
+		LastValidatorPowers:   []LastValidatorPower{},
+		Validators:            validators,
+		Exported:              false,
+		BridgeInfo:            bridgeInfo,
+		DenomPairs:            []DenomPair{},
+		MigrationInfos:        migrationInfos,
+		PendingDeposits:       []PendingDeposit{},
+		ExecutorChangePlans:   []ExecutorChangePlan{},
+		Sequencers:            []Sequencer{},
+		ForcedTxs:             []ophosttypes.ForcedTx{},
+		WithdrawalTreeNodes:   []WithdrawalTreeNode{},
+		WithdrawalRecords:     []WithdrawalRecord{},
+		BlockHashes:           []BlockHash{},
+		DepositRecords:        []DepositRecord{},
+		MigrationStatuses:     []MigrationStatus{},
+		AutoMigrations:        []AutoMigration{},
+		BaseFeeMultiplier:     math.LegacyOneDec(),
+		Sponsorships:          []Sponsorship{},
+		FeeDistributionTotals: []FeeDistributionTotal{},
 	}
 }
 
 // DefaultGenesisState gets the raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:              DefaultParams(),
-		LastValidatorPowers: []LastValidatorPower{},
-		Validators:          []Validator{},
-		NextL1Sequence:      DefaultL1SequenceStart,
-		NextL2Sequence:      DefaultL2SequenceStart,
-		BridgeInfo:          nil,
-		Exported:            false,
-		DenomPairs:          []DenomPair{},
-		MigrationInfos:      []MigrationInfo{},
+		Params:                DefaultParams(),
+		LastValidatorPowers:   []LastValidatorPower{},
+		Validators:            []Validator{},
+		NextL1Sequence:        DefaultL1SequenceStart,
+		NextL2Sequence:        DefaultL2SequenceStart,
+		BridgeInfo:            nil,
+		Exported:              false,
+		DenomPairs:            []DenomPair{},
+		MigrationInfos:        []MigrationInfo{},
+		PendingDeposits:       []PendingDeposit{},
+		ExecutorChangePlans:   []ExecutorChangePlan{},
+		Sequencers:            []Sequencer{},
+		ForcedTxs:             []ophosttypes.ForcedTx{},
+		NextForcedTxSequence:  ophosttypes.DefaultForcedTxSequenceStart,
+		WithdrawalTreeNodes:   []WithdrawalTreeNode{},
+		WithdrawalRecords:     []WithdrawalRecord{},
+		BlockHashes:           []BlockHash{},
+		DepositRecords:        []DepositRecord{},
+		MigrationStatuses:     []MigrationStatus{},
+		AutoMigrations:        []AutoMigration{},
+		BaseFeeMultiplier:     math.LegacyOneDec(),
+		Sponsorships:          []Sponsorship{},
+		FeeDistributionTotals: []FeeDistributionTotal{},
 	}
 }
 
 // ValidateGenesis performs basic validation of rollup genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data *GenesisState, ac address.Codec) error {
+func ValidateGenesis(data *GenesisState, ac address.Codec, vc address.Codec) error {
 	if err := validateGenesisStateValidators(data.Validators); err != nil {
 		return err
 	}
@@ -72,6 +102,80 @@ func ValidateGenesis(data *GenesisState, ac address.Codec) error {
 		}
 	}
 
+	for _, migrationStatus := range data.MigrationStatuses {
+		if err := migrationStatus.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, autoMigration := range data.AutoMigrations {
+		if err := sdk.ValidateDenom(autoMigration.Denom); err != nil {
+			return err
+		}
+	}
+
+	if !data.BaseFeeMultiplier.IsNil() && data.BaseFeeMultiplier.LT(math.LegacyOneDec()) {
+		return ErrInvalidFeeMarket.Wrapf("base fee multiplier %s is less than one", data.BaseFeeMultiplier)
+	}
+
+	sponsorships := make(map[[2]string]bool, len(data.Sponsorships))
+	for _, sponsorship := range data.Sponsorships {
+		if err := sponsorship.Validate(ac); err != nil {
+			return err
+		}
+
+		key := [2]string{sponsorship.Account, sponsorship.MsgTypeUrl}
+		if sponsorships[key] {
+			return ErrInvalidSponsorship.Wrapf("duplicate sponsorship of %s and %s", sponsorship.Account, sponsorship.MsgTypeUrl)
+		}
+		sponsorships[key] = true
+	}
+
+	for _, total := range data.FeeDistributionTotals {
+		if err := total.Amount.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidatePendingDeposits(data.PendingDeposits, data.NextL1Sequence, ac); err != nil {
+		return err
+	}
+
+	heights := make(map[uint64]bool, len(data.ExecutorChangePlans))
+	for _, plan := range data.ExecutorChangePlans {
+		if err := plan.Validate(ac, vc); err != nil {
+			return err
+		}
+
+		if heights[plan.Height] {
+			return ErrAlreadyRegisteredHeight
+		}
+		heights[plan.Height] = true
+	}
+
+	if err := ValidateSequencers(data.Sequencers, vc); err != nil {
+		return err
+	}
+
+	// the forced txs in genesis are not processed yet
+	for _, forcedTx := range data.ForcedTxs {
+		if forcedTx.Sequence < data.NextForcedTxSequence || len(forcedTx.Tx) == 0 {
+			return ErrInvalidSequence.Wrapf("invalid forced tx %d", forcedTx.Sequence)
+		}
+	}
+
+	for _, node := range data.WithdrawalTreeNodes {
+		if len(node.Hash) != 32 {
+			return fmt.Errorf("invalid withdrawal tree node hash at (%d, %d)", node.Level, node.Index)
+		}
+	}
+
+	for _, record := range data.WithdrawalRecords {
+		if record.Sequence == 0 || record.Sequence >= data.NextL2Sequence {
+			return ErrInvalidSequence.Wrapf("invalid withdrawal record %d", record.Sequence)
+		}
+	}
+
 	return data.Params.Validate(ac)
 }
 
@@ -120,5 +224,18 @@ func (g GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+
+	for i := range g.ExecutorChangePlans {
+		if err := g.ExecutorChangePlans[i].NextValidator.UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
+
+	for i := range g.Sequencers {
+		if err := g.Sequencers[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
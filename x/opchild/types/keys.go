@@ -16,6 +16,10 @@ const (
 
 	// RouterKey is the msg router key for the opchild module
 	RouterKey = ModuleName
+
+	// MEVRewardsEscrowName is the name from which the escrow address of the MEV auction
+	// proceeds is derived
+	MEVRewardsEscrowName = "opchild_mev_rewards"
 )
 
 var (
@@ -24,6 +28,8 @@ var (
 	NextL2SequenceKey = []byte{0x12} // key for the outbound sequence number
 	BridgeInfoKey     = []byte{0x13} // prefix for bridge_info
 	NextL1SequenceKey = []byte{0x14} // prefix for inbound deposit sequence number
+	OPinitChannelKey  = []byte{0x15} // key for the L2 side channel id of the opinit channel
+	StatusReportKey   = []byte{0x16} // key for the height of the last L2 status report
 
 	HistoricalInfoPrefix = []byte{0x21} // prefix for the historical info
 
@@ -40,4 +46,34 @@ var (
 	MigrationInfoPrefix   = []byte{0x61} // prefix for the migration info
 	IBCToL2DenomMapPrefix = []byte{0x62} // prefix for the ibc to l2 denom map
 	ShutdownInfoPrefix    = []byte{0x63} // prefix for the shutdown info
+	MigrationStatusPrefix = []byte{0x64} // prefix for the migration status
+	AutoMigrationPrefix   = []byte{0x65} // prefix for the auto migration progress
+
+	PendingDepositPrefix = []byte{0x71} // prefix for the pending deposits
+
+	DepositVotePrefix    = []byte{0x81} // prefix for the bridge executor votes on deposits
+	BridgeInfoVotePrefix = []byte{0x82} // prefix for the bridge executor votes on bridge info
+
+	ExecutorChangePlanPrefix = []byte{0x91} // prefix for the executor change plans
+
+	SequencersPrefix = []byte{0xa1} // prefix for the registered sequencers
+
+	ForcedTxPrefix          = []byte{0xb1} // prefix for the forced txs received from L1
+	NextForcedTxSequenceKey = []byte{0xb2} // key for the next forced tx sequence to be processed
+
+	WithdrawalTreePrefix              = []byte{0xc1} // prefix for the withdrawal merkle tree nodes
+	WithdrawalRecordPrefix            = []byte{0xc2} // prefix for the withdrawal records
+	BlockHashPrefix                   = []byte{0xc3} // prefix for the l2 block hashes
+	WithdrawalRecordsBySenderPrefix   = []byte{0xc4} // prefix for the withdrawal records index by sender
+	WithdrawalRecordsByReceiverPrefix = []byte{0xc5} // prefix for the withdrawal records index by receiver
+
+	DepositRecordPrefix       = []byte{0xd1} // prefix for the deposit records
+	FailedDepositRecordPrefix = []byte{0xd2} // prefix for the failed deposit records index
+
+	BaseFeeMultiplierKey  = []byte{0xe1} // key for the base fee multiplier of the fee market
+	SponsorshipPrefix     = []byte{0xe2} // prefix for the gas budget sponsorships
+	FeeDistributionPrefix = []byte{0xe3} // prefix for the accumulated fees distributed to the recipients
+	BlockGasUsedKey       = []byte{0xe4} // key for the gas used by the txs in the current block
+
+	SponsorshipUsagePrefix = []byte{0xe5} // prefix for the gas used by the fee payers of the msg type sponsorships
 )
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// The names of the block-sdk lanes built by the app.
+const (
+	SystemLaneName  = "system"
+	FreeLaneName    = "free"
+	MEVLaneName     = "mev"
+	DefaultLaneName = "default"
+)
+
+// Validate performs basic validation of the lane config.
+func (c LaneConfig) Validate() error {
+	if c.Name == "" {
+		return ErrInvalidLaneConfig.Wrap("empty lane name")
+	}
+
+	if c.MaxBlockSpace.IsNil() || c.MaxBlockSpace.IsNegative() || c.MaxBlockSpace.GT(math.LegacyOneDec()) {
+		return ErrInvalidLaneConfig.Wrapf("invalid max block space %s of %s", c.MaxBlockSpace, c.Name)
+	}
+
+	return nil
+}
+
+// ValidateLaneConfigs checks the lane configs have distinct lane names, and do not disable the
+// system lane, which carries the bridge and the oracle txs, or the default lane, which carries
+// the rest of the txs.
+func ValidateLaneConfigs(configs []LaneConfig) error {
+	names := make(map[string]bool, len(configs))
+	for _, config := range configs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		if config.Disabled && (config.Name == SystemLaneName || config.Name == DefaultLaneName) {
+			return ErrInvalidLaneConfig.Wrapf("lane %s cannot be disabled", config.Name)
+		}
+
+		if names[config.Name] {
+			return ErrInvalidLaneConfig.Wrapf("duplicate lane config %s", config.Name)
+		}
+		names[config.Name] = true
+	}
+
+	return nil
+}
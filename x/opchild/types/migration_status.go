@@ -0,0 +1,33 @@
+package types
+
+import (
+	errors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMigrationStatus returns the zero migration counters of the OP token.
+func NewMigrationStatus(denom string) MigrationStatus {
+	return MigrationStatus{
+		Denom:      denom,
+		Migrated:   sdk.NewCoin(denom, math.ZeroInt()),
+		Unmigrated: sdk.NewCoin(denom, math.ZeroInt()),
+	}
+}
+
+func (m MigrationStatus) Validate() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if !m.Migrated.IsValid() || m.Migrated.Denom != m.Denom {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid migrated amount: %s", m.Migrated)
+	}
+
+	if !m.Unmigrated.IsValid() || m.Unmigrated.Denom != m.Denom {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid unmigrated amount: %s", m.Unmigrated)
+	}
+
+	return nil
+}
@@ -11,11 +11,20 @@ import (
 var (
 	DefaultMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(15, 2))) // 0.15
 	DefaultHookMaxGas   = uint64(1_000_000)
+
+	DefaultMaxPendingDeposits    = uint64(100)
+	DefaultPendingDepositTimeout = uint64(100_000)
+
+	DefaultBaseFeeChangeDenominator = uint64(8)
+	DefaultMaxOraclePriceAge        = uint64(600) // 10 minutes
+	DefaultMaxBaseFeeMultiplier     = math.LegacyNewDec(100)
+
+	DefaultMaxForcedTxsPerBlock = uint64(10)
 )
 
 // DefaultParams returns default move parameters
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		"",
 		[]string{""},
 		DefaultMaxValidators,
@@ -24,6 +33,15 @@ func DefaultParams() Params {
 		[]string{},
 		DefaultHookMaxGas,
 	)
+	params.MaxPendingDeposits = DefaultMaxPendingDeposits
+	params.PendingDepositTimeout = DefaultPendingDepositTimeout
+	params.BaseFeeChangeDenominator = DefaultBaseFeeChangeDenominator
+	params.MaxOraclePriceAge = DefaultMaxOraclePriceAge
+	params.MevRewardsRatio = math.LegacyZeroDec()
+	params.MaxForcedTxsPerBlock = DefaultMaxForcedTxsPerBlock
+	params.MaxBaseFeeMultiplier = DefaultMaxBaseFeeMultiplier
+
+	return params
 }
 
 // NewParams creates a new Params instance
@@ -67,6 +85,60 @@ func (p Params) Validate(ac address.Codec) error {
 		return ErrZeroMaxValidators
 	}
 
+	if p.MaxSequencers > p.MaxValidators {
+		return ErrInvalidMaxSequencers.Wrapf("max sequencers %d exceeds max validators %d", p.MaxSequencers, p.MaxValidators)
+	}
+
+	if p.BridgeExecutorThreshold > uint64(len(p.BridgeExecutors)) && p.BridgeExecutorThreshold > 1 {
+		return ErrInvalidExecutorThreshold.Wrapf("threshold %d exceeds the number of bridge executors %d", p.BridgeExecutorThreshold, len(p.BridgeExecutors))
+	}
+
+	if p.TargetBlockGas > 0 && p.BaseFeeChangeDenominator == 0 {
+		return ErrInvalidFeeMarket.Wrap("base fee change denominator cannot be zero")
+	}
+
+	if !p.MaxBaseFeeMultiplier.IsNil() && !p.MaxBaseFeeMultiplier.IsZero() && p.MaxBaseFeeMultiplier.LT(math.LegacyOneDec()) {
+		return ErrInvalidFeeMarket.Wrapf("max base fee multiplier %s cannot be less than one", p.MaxBaseFeeMultiplier)
+	}
+
+	if len(p.FeeDenomOracles) > 0 {
+		if err := sdk.ValidateDenom(p.FeeBaseDenom); err != nil {
+			return ErrInvalidFeeMarket.Wrapf("invalid fee base denom: %s", err)
+		}
+	}
+
+	feeDenoms := make(map[string]bool, len(p.FeeDenomOracles))
+	for _, oracle := range p.FeeDenomOracles {
+		if err := oracle.Validate(); err != nil {
+			return err
+		}
+
+		if oracle.Denom == p.FeeBaseDenom {
+			return ErrInvalidFeeMarket.Wrapf("fee base denom %s cannot be priced by the oracle", oracle.Denom)
+		} else if feeDenoms[oracle.Denom] {
+			return ErrInvalidFeeMarket.Wrapf("duplicate fee denom oracle %s", oracle.Denom)
+		}
+		feeDenoms[oracle.Denom] = true
+	}
+
+	if err := ValidateFeeSplits(ac, p.FeeSplits); err != nil {
+		return err
+	}
+
+	if err := ValidateLaneConfigs(p.LaneConfigs); err != nil {
+		return err
+	}
+
+	if !p.MevRewardsRatio.IsNil() && !p.MevRewardsRatio.IsZero() {
+		if p.MevRewardsRatio.IsNegative() || p.MevRewardsRatio.GT(math.LegacyOneDec()) {
+			return ErrInvalidMEVRewards.Wrapf("invalid mev rewards ratio %s", p.MevRewardsRatio)
+		}
+
+		if _, err := ac.StringToBytes(p.MevRewardsAddress); err != nil {
+			return ErrInvalidMEVRewards.Wrapf("invalid mev rewards address: %s", err)
+		}
+	}
+
 	// Validate fee whitelist addresses
 	for _, addr := range p.FeeWhitelist {
 		if _, err := ac.StringToBytes(addr); err != nil {
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ToMsg converts the pending deposit to the MsgFinalizeTokenDeposit.
+func (d PendingDeposit) ToMsg() *MsgFinalizeTokenDeposit {
+	return NewMsgFinalizeTokenDeposit(d.Sender, d.From, d.To, d.Amount, d.Sequence, d.Height, d.BaseDenom, d.Data)
+}
+
+// ValidatePendingDeposits validates the pending deposits against the next l1 sequence.
+func ValidatePendingDeposits(deposits []PendingDeposit, nextL1Sequence uint64, ac address.Codec) error {
+	seen := make(map[uint64]bool, len(deposits))
+	for _, deposit := range deposits {
+		if deposit.Sequence <= nextL1Sequence {
+			return errors.Wrapf(ErrInvalidSequence, "pending deposit sequence %d must be greater than the next l1 sequence %d", deposit.Sequence, nextL1Sequence)
+		}
+
+		if seen[deposit.Sequence] {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pending deposit sequence %d", deposit.Sequence)
+		}
+		seen[deposit.Sequence] = true
+
+		if err := deposit.ToMsg().Validate(ac); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewSequencer constructs a new Sequencer
+func NewSequencer(operator sdk.ValAddress, pubKey cryptotypes.PubKey, moniker string, weight int64) (Sequencer, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return Sequencer{}, err
+	}
+
+	return Sequencer{
+		Moniker:         moniker,
+		OperatorAddress: operator.String(),
+		ConsensusPubkey: pkAny,
+		Weight:          weight,
+	}, nil
+}
+
+// Validate performs basic validation of the sequencer.
+func (s Sequencer) Validate(vc address.Codec) error {
+	if _, err := vc.StringToBytes(s.OperatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sequencer address: %s", err)
+	}
+
+	if s.ConsensusPubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	if s.Weight <= 0 {
+		return errorsmod.Wrapf(ErrInvalidSequencerWeight, "weight must be positive, got %d", s.Weight)
+	}
+
+	return nil
+}
+
+// ConsPubKey returns the sequencer PubKey as a cryptotypes.PubKey.
+func (s Sequencer) ConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := s.ConsensusPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// ToValidator returns the validator of the sequencer with its weight as consensus power.
+func (s Sequencer) ToValidator() Validator {
+	return Validator{
+		Moniker:         s.Moniker,
+		OperatorAddress: s.OperatorAddress,
+		ConsensusPubkey: s.ConsensusPubkey,
+		ConsPower:       s.Weight,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s Sequencer) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(s.ConsensusPubkey, &pk)
+}
+
+// ValidateSequencers performs basic validation of the sequencer set and checks
+// there are no duplicate operators or consensus keys.
+func ValidateSequencers(sequencers []Sequencer, vc address.Codec) error {
+	operators := make(map[string]bool, len(sequencers))
+	pubkeys := make(map[string]bool, len(sequencers))
+	for _, sequencer := range sequencers {
+		if err := sequencer.Validate(vc); err != nil {
+			return err
+		}
+
+		if operators[sequencer.OperatorAddress] {
+			return errorsmod.Wrapf(ErrSequencerExists, "duplicate sequencer %s", sequencer.OperatorAddress)
+		}
+		operators[sequencer.OperatorAddress] = true
+
+		pk, err := sequencer.ConsPubKey()
+		if err != nil {
+			return err
+		}
+		if pubkeys[string(pk.Bytes())] {
+			return errorsmod.Wrapf(ErrValidatorPubKeyExists, "duplicate consensus pubkey of sequencer %s", sequencer.OperatorAddress)
+		}
+		pubkeys[string(pk.Bytes())] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+)
+
+// Validate performs basic validation of the sponsorship.
+func (s Sponsorship) Validate(ac address.Codec) error {
+	if err := validateSponsorshipKey(ac, s.Account, s.MsgTypeUrl); err != nil {
+		return err
+	}
+
+	if s.GasBudget == 0 {
+		return ErrInvalidSponsorship.Wrap("gas budget must be positive")
+	}
+
+	// a fee payer of the msg type sponsorship can only use its account gas limit in a period
+	if s.Account == "" && s.AccountGasLimit == 0 {
+		return ErrInvalidSponsorship.Wrap("account gas limit must be positive for the sponsorship of any fee payer")
+	} else if s.Account != "" && s.AccountGasLimit != 0 {
+		return ErrInvalidSponsorship.Wrap("account gas limit is only for the sponsorship of any fee payer")
+	} else if s.AccountGasLimit > s.GasBudget {
+		return ErrInvalidSponsorship.Wrap("account gas limit cannot exceed the gas budget")
+	}
+
+	return nil
+}
+
+// Remaining returns the gas limit which can still be sponsored in the current period.
+func (s Sponsorship) Remaining() uint64 {
+	if s.GasUsed >= s.GasBudget {
+		return 0
+	}
+
+	return s.GasBudget - s.GasUsed
+}
+
+// AccountRemaining returns the gas limit which can still be sponsored to a fee payer who has
+// used the given gas in the current period.
+func (s Sponsorship) AccountRemaining(accountGasUsed uint64) uint64 {
+	if accountGasUsed >= s.AccountGasLimit {
+		return 0
+	}
+
+	return s.AccountGasLimit - accountGasUsed
+}
+
+// validateSponsorshipKey checks the sponsored account and message type; at least one of them
+// must be set, so a sponsorship never pays the fees of every tx.
+func validateSponsorshipKey(ac address.Codec, account, msgTypeURL string) error {
+	if account == "" && msgTypeURL == "" {
+		return ErrInvalidSponsorship.Wrap("either account or msg type url must be set")
+	}
+
+	if account != "" {
+		if _, err := ac.StringToBytes(account); err != nil {
+			return ErrInvalidSponsorship.Wrapf("invalid account address: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 )
 
 var (
@@ -27,10 +29,21 @@ var (
 	_ sdk.Msg = &MsgInitiateTokenWithdrawal{}
 	_ sdk.Msg = &MsgRegisterMigrationInfo{}
 	_ sdk.Msg = &MsgMigrateToken{}
+	_ sdk.Msg = &MsgUnmigrateToken{}
+	_ sdk.Msg = &MsgUpdateMigrationToggles{}
 	_ sdk.Msg = &MsgRelayOracleData{}
+	_ sdk.Msg = &MsgFinalizeTokenDepositWithProof{}
+	_ sdk.Msg = &MsgScheduleExecutorChange{}
+	_ sdk.Msg = &MsgCancelExecutorChange{}
+	_ sdk.Msg = &MsgAddSequencer{}
+	_ sdk.Msg = &MsgRemoveSequencer{}
+	_ sdk.Msg = &MsgRegisterSponsorship{}
+	_ sdk.Msg = &MsgRemoveSponsorship{}
 
 	_ codectypes.UnpackInterfacesMessage = &MsgExecuteMessages{}
 	_ codectypes.UnpackInterfacesMessage = &MsgUpdateSequencer{}
+	_ codectypes.UnpackInterfacesMessage = &MsgScheduleExecutorChange{}
+	_ codectypes.UnpackInterfacesMessage = &MsgAddSequencer{}
 )
 
 // should refer initiavm/precompile/modules/minlib/sources/coin.move
@@ -127,6 +140,78 @@ func (msg MsgUpdateSequencer) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
 }
 
+/* MsgAddSequencer */
+
+// NewMsgAddSequencer creates a new MsgAddSequencer instance.
+func NewMsgAddSequencer(
+	moniker string, authority string, seqAddr string, pubKey cryptotypes.PubKey, weight int64,
+) (*MsgAddSequencer, error) {
+	if pubKey == nil {
+		return nil, ErrEmptyValidatorPubKey
+	}
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgAddSequencer{
+		Moniker:          moniker,
+		Authority:        authority,
+		SequencerAddress: seqAddr,
+		Pubkey:           pkAny,
+		Weight:           weight,
+	}, nil
+}
+
+// Validate performs basic MsgAddSequencer message validation.
+func (msg MsgAddSequencer) Validate(ac address.Codec, vc address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := vc.StringToBytes(msg.SequencerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sequencer address: %s", err)
+	}
+
+	if msg.Pubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	if msg.Weight <= 0 {
+		return ErrInvalidSequencerWeight.Wrapf("weight must be positive, got %d", msg.Weight)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgAddSequencer) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
+}
+
+/* MsgRemoveSequencer */
+
+// NewMsgRemoveSequencer creates a new MsgRemoveSequencer instance.
+func NewMsgRemoveSequencer(authority string, seqAddr string) *MsgRemoveSequencer {
+	return &MsgRemoveSequencer{
+		Authority:        authority,
+		SequencerAddress: seqAddr,
+	}
+}
+
+// Validate performs basic MsgRemoveSequencer message validation.
+func (msg MsgRemoveSequencer) Validate(ac address.Codec, vc address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := vc.StringToBytes(msg.SequencerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sequencer address: %s", err)
+	}
+
+	return nil
+}
+
 /* MsgInitiateTokenWithdrawal */
 
 // NewMsgInitiateTokenWithdrawal creates a new MsgInitiateTokenWithdrawal instance.
@@ -518,6 +603,51 @@ func (msg MsgMigrateToken) Validate(ac address.Codec) error {
 	return nil
 }
 
+/* MsgUnmigrateToken */
+
+// NewMsgUnmigrateToken creates a new MsgUnmigrateToken instance.
+func NewMsgUnmigrateToken(sender string, amount sdk.Coin) *MsgUnmigrateToken {
+	return &MsgUnmigrateToken{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Validate performs basic MsgUnmigrateToken message validation.
+func (msg MsgUnmigrateToken) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+/* MsgUpdateMigrationToggles */
+
+// NewMsgUpdateMigrationToggles creates a new MsgUpdateMigrationToggles instance.
+func NewMsgUpdateMigrationToggles(authority, denom string, unmigrationEnabled, migrationPaused, autoMigrate bool) *MsgUpdateMigrationToggles {
+	return &MsgUpdateMigrationToggles{
+		Authority:          authority,
+		Denom:              denom,
+		UnmigrationEnabled: unmigrationEnabled,
+		MigrationPaused:    migrationPaused,
+		AutoMigrate:        autoMigrate,
+	}
+}
+
+// Validate performs basic MsgUpdateMigrationToggles message validation.
+func (msg MsgUpdateMigrationToggles) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	return sdk.ValidateDenom(msg.Denom)
+}
+
 /* MsgRelayOracleData */
 
 // NewMsgRelayOracleData creates a new MsgRelayOracleData instance.
@@ -539,3 +669,191 @@ func (msg MsgRelayOracleData) Validate(ac address.Codec) error {
 
 	return nil
 }
+
+/* MsgFinalizeTokenDepositWithProof */
+
+// NewMsgFinalizeTokenDepositWithProof creates a new MsgFinalizeTokenDepositWithProof instance.
+func NewMsgFinalizeTokenDepositWithProof(
+	sender, from, to string,
+	amount sdk.Coin,
+	sequence uint64,
+	height uint64,
+	baseDenom string,
+	data []byte,
+	proof []byte,
+	proofHeight clienttypes.Height,
+) *MsgFinalizeTokenDepositWithProof {
+	return &MsgFinalizeTokenDepositWithProof{
+		Sender:      sender,
+		From:        from,
+		To:          to,
+		Amount:      amount,
+		Sequence:    sequence,
+		Height:      height,
+		BaseDenom:   baseDenom,
+		Data:        data,
+		Proof:       proof,
+		ProofHeight: proofHeight,
+	}
+}
+
+func (msg MsgFinalizeTokenDepositWithProof) Validate(ac address.Codec) error {
+	if err := msg.ToFinalizeTokenDeposit().Validate(ac); err != nil {
+		return err
+	}
+
+	if len(msg.Proof) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("proof cannot be empty")
+	}
+
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.ErrInvalidRequest.Wrap("proof height cannot be zero")
+	}
+
+	return nil
+}
+
+// ToFinalizeTokenDeposit converts the message to the MsgFinalizeTokenDeposit to share the
+// deposit finalization with the bridge executor.
+func (msg MsgFinalizeTokenDepositWithProof) ToFinalizeTokenDeposit() *MsgFinalizeTokenDeposit {
+	return NewMsgFinalizeTokenDeposit(msg.Sender, msg.From, msg.To, msg.Amount, msg.Sequence, msg.Height, msg.BaseDenom, msg.Data)
+}
+
+/* MsgScheduleExecutorChange */
+
+// NewMsgScheduleExecutorChange creates a new MsgScheduleExecutorChange instance.
+func NewMsgScheduleExecutorChange(
+	authority string,
+	proposalID, height uint64,
+	moniker, valAddr string,
+	pubKey cryptotypes.PubKey,
+	nextExecutors []string,
+	info string,
+) (*MsgScheduleExecutorChange, error) {
+	if pubKey == nil {
+		return nil, ErrEmptyValidatorPubKey
+	}
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgScheduleExecutorChange{
+		Authority:        authority,
+		ProposalID:       proposalID,
+		Height:           height,
+		Moniker:          moniker,
+		ValidatorAddress: valAddr,
+		Pubkey:           pkAny,
+		NextExecutors:    nextExecutors,
+		Info:             info,
+	}, nil
+}
+
+// Validate performs basic MsgScheduleExecutorChange message validation.
+func (msg MsgScheduleExecutorChange) Validate(ac address.Codec, vc address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := vc.StringToBytes(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.Pubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	if msg.ProposalID == 0 {
+		return errors.Wrap(ErrInvalidExecutorChangePlan, "invalid proposal id")
+	}
+
+	if msg.Height == 0 {
+		return errors.Wrap(ErrInvalidExecutorChangePlan, "invalid height")
+	}
+
+	for _, nextExecutor := range msg.NextExecutors {
+		if _, err := ac.StringToBytes(nextExecutor); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid next executor address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgScheduleExecutorChange) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
+}
+
+/* MsgCancelExecutorChange */
+
+// NewMsgCancelExecutorChange creates a new MsgCancelExecutorChange instance.
+func NewMsgCancelExecutorChange(authority string, height uint64) *MsgCancelExecutorChange {
+	return &MsgCancelExecutorChange{
+		Authority: authority,
+		Height:    height,
+	}
+}
+
+// Validate performs basic MsgCancelExecutorChange message validation.
+func (msg MsgCancelExecutorChange) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.Height == 0 {
+		return errors.Wrap(ErrInvalidExecutorChangePlan, "invalid height")
+	}
+
+	return nil
+}
+
+/* MsgRegisterSponsorship */
+
+// NewMsgRegisterSponsorship creates a new MsgRegisterSponsorship instance.
+func NewMsgRegisterSponsorship(authority, account, msgTypeURL string, gasBudget, period, accountGasLimit uint64) *MsgRegisterSponsorship {
+	return &MsgRegisterSponsorship{
+		Authority:       authority,
+		Account:         account,
+		MsgTypeUrl:      msgTypeURL,
+		GasBudget:       gasBudget,
+		Period:          period,
+		AccountGasLimit: accountGasLimit,
+	}
+}
+
+// Validate performs basic MsgRegisterSponsorship message validation.
+func (msg MsgRegisterSponsorship) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return Sponsorship{
+		Account:         msg.Account,
+		MsgTypeUrl:      msg.MsgTypeUrl,
+		GasBudget:       msg.GasBudget,
+		Period:          msg.Period,
+		AccountGasLimit: msg.AccountGasLimit,
+	}.Validate(ac)
+}
+
+/* MsgRemoveSponsorship */
+
+// NewMsgRemoveSponsorship creates a new MsgRemoveSponsorship instance.
+func NewMsgRemoveSponsorship(authority, account, msgTypeURL string) *MsgRemoveSponsorship {
+	return &MsgRemoveSponsorship{
+		Authority:  authority,
+		Account:    account,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// Validate performs basic MsgRemoveSponsorship message validation.
+func (msg MsgRemoveSponsorship) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateSponsorshipKey(ac, msg.Account, msg.MsgTypeUrl)
+}
@@ -39,11 +39,15 @@ func GetTxCmd(ac address.Codec, vc address.Codec) *cobra.Command {
 		NewDeleteOutput(ac),
 		NewInitiateTokenDeposit(ac),
 		NewFinalizeTokenWithdrawal(ac),
+		NewSubmitForcedTx(ac),
 		NewUpdateProposer(ac),
 		NewUpdateChallenger(ac),
 		NewUpdateBatchInfo(ac),
 		NewUpdateMetadata(ac),
 		NewUpdateOracleConfig(ac),
+		NewAddPermChannel(ac),
+		NewRemovePermChannel(ac),
+		NewUpdatePermChannelAdmin(ac),
 	)
 
 	return ophostTxCmd
@@ -109,7 +113,8 @@ func NewCreateBridge(ac address.Codec, vc address.Codec) *cobra.Command {
 					"finalization_period": "duration",
 					"submission_start_height" : "l2-block-height",
 					"batch_info": {"submitter": "bech32-address","chain": "INITIA|CELESTIA"},
-					"metadata": "{\"perm_channels\":[{\"port_id\":\"transfer\", \"channel_id\":\"channel-0\"}, {\"port_id\":\"icqhost\", \"channel_id\":\"channel-1\"}]}"
+					"perm_channels": [{"port_id": "transfer", "channel_id": "channel-0"}, {"port_id": "icqhost", "channel_id": "channel-1"}],
+					"perm_channel_admin": {"role": "CHALLENGER|PROPOSER|ACCOUNT", "address": "bech32-address (ACCOUNT only)"}
 				}`, version.AppName,
 			),
 		),
@@ -156,6 +161,10 @@ func NewCreateBridge(ac address.Codec, vc address.Codec) *cobra.Command {
 				Metadata:              []byte(origConfig.Metadata),
 				BatchInfo:             origConfig.BatchInfo,
 				OracleEnabled:         origConfig.OracleEnabled,
+				OraclePushInterval:    origConfig.OraclePushInterval,
+				PermChannels:          origConfig.PermChannels,
+				PermChannelAdmin:      origConfig.PermChannelAdmin,
+				ForcedInclusionPeriod: origConfig.ForcedInclusionPeriod,
 			}
 
 			if err = config.Validate(ac, vc); err != nil {
@@ -328,6 +337,47 @@ func NewInitiateTokenDeposit(ac address.Codec) *cobra.Command {
 	return cmd
 }
 
+// NewSubmitForcedTx returns a CLI command handler for transaction to submit a L2 tx to be force included.
+func NewSubmitForcedTx(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-forced-tx [bridge-id] [l2-tx-hex]",
+		Short: "submit a signed L2 tx to be force included on L2",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bridgeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			l2Tx, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitForcedTx(fromAddr, bridgeId, l2Tx)
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewFinalizeTokenWithdrawal returns a CLI command handler for transaction to finalize token withdrawal.
 func NewFinalizeTokenWithdrawal(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -559,9 +609,17 @@ func parseBatchChainType(chain string) (types.BatchInfo_ChainType, error) {
 
 func NewUpdateOracleConfig(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-oracle-config [bridge-id] [oracle-enabled:true|false]",
+		Use:   "update-oracle-config [bridge-id] [oracle-enabled:true|false] [oracle-push-interval]",
 		Short: "send a tx to update oracle config",
-		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`send a tx to update oracle config. The optional oracle-push-interval is the number of
+				L1 blocks between oracle price packets pushed to L2; zero (default) disables push-based delivery.
+				Example:
+				$ %s tx ophost update-oracle-config 1 true 10`, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -578,12 +636,158 @@ func NewUpdateOracleConfig(ac address.Codec) *cobra.Command {
 				return err
 			}
 
+			var oraclePushInterval uint64
+			if len(args) == 3 {
+				oraclePushInterval, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateOracleConfig(fromAddr, bridgeId, oracleEnabled, oraclePushInterval)
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddPermChannel(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-perm-channel [bridge-id] [port-id] [channel-id]",
+		Short: "send a tx to add a permissioned channel to the bridge",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`send a tx to add a permissioned channel to the bridge. The perm channel admin of the bridge
+				is registered as the ibcperm admin of the channel.
+				Example:
+				$ %s tx ophost add-perm-channel 1 transfer channel-0`, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bridgeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddPermChannel(fromAddr, bridgeId, args[1], args[2])
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemovePermChannel(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-perm-channel [bridge-id] [port-id] [channel-id]",
+		Short: "send a tx to remove a permissioned channel from the bridge",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`send a tx to remove a permissioned channel from the bridge.
+				Example:
+				$ %s tx ophost remove-perm-channel 1 transfer channel-0`, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bridgeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemovePermChannel(fromAddr, bridgeId, args[1], args[2])
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUpdatePermChannelAdmin(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-perm-channel-admin [bridge-id] [role:CHALLENGER|PROPOSER|ACCOUNT] [address]",
+		Short: "send a tx to update the admin of the permissioned channels",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`send a tx to update the admin of the permissioned channels. The address is only
+				required for the ACCOUNT role.
+				Example:
+				$ %s tx ophost update-perm-channel-admin 1 ACCOUNT init1...`, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bridgeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			role, ok := types.PermChannelAdmin_Role_value[strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid role %q, expected CHALLENGER, PROPOSER or ACCOUNT", args[1])
+			}
+
+			admin := types.PermChannelAdmin{Role: types.PermChannelAdmin_Role(role)}
+			if len(args) == 3 {
+				admin.Address = args[2]
+			}
+
 			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgUpdateOracleConfig(fromAddr, bridgeId, oracleEnabled)
+			msg := types.NewMsgUpdatePermChannelAdmin(fromAddr, bridgeId, admin)
 			if err = msg.Validate(ac); err != nil {
 				return err
 			}
@@ -527,6 +527,32 @@ func (s *CLITestSuite) TestNewUpdateOracleConfig() {
 			},
 			false,
 		},
+		{
+			"invalid oracle push interval",
+			[]string{
+				"1",
+				"true",
+				"not-uint",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, addr0),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10))).String()),
+			},
+			true,
+		},
+		{
+			"valid transaction with oracle push interval",
+			[]string{
+				"1",
+				"true",
+				"10",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, addr0),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10))).String()),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
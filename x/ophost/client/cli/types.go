@@ -9,14 +9,18 @@ import (
 // BridgeConfig defines the set of bridge config.
 // NOTE: it is a modified BridgeConfig from x/ophost/types/types.go to make unmarshal easier
 type BridgeCliConfig struct {
-	Challenger            string          `json:"challenger"`
-	Proposer              string          `json:"proposer"`
-	SubmissionInterval    string          `json:"submission_interval"`
-	FinalizationPeriod    string          `json:"finalization_period"`
-	SubmissionStartHeight string          `json:"submission_start_height"`
-	Metadata              string          `json:"metadata"`
-	BatchInfo             types.BatchInfo `json:"batch_info"`
-	OracleEnabled         bool            `json:"oracle_enabled"`
-	BridgeDisabled        bool            `json:"bridge_disabled"`
-	BridgeDisabledAt      time.Time       `json:"bridge_disabled_at"`
+	Challenger            string                 `json:"challenger"`
+	Proposer              string                 `json:"proposer"`
+	SubmissionInterval    string                 `json:"submission_interval"`
+	FinalizationPeriod    string                 `json:"finalization_period"`
+	SubmissionStartHeight string                 `json:"submission_start_height"`
+	Metadata              string                 `json:"metadata"`
+	BatchInfo             types.BatchInfo        `json:"batch_info"`
+	OracleEnabled         bool                   `json:"oracle_enabled"`
+	OraclePushInterval    uint64                 `json:"oracle_push_interval"`
+	BridgeDisabled        bool                   `json:"bridge_disabled"`
+	BridgeDisabledAt      time.Time              `json:"bridge_disabled_at"`
+	PermChannels          []types.PermChannel    `json:"perm_channels"`
+	PermChannelAdmin      types.PermChannelAdmin `json:"perm_channel_admin"`
+	ForcedInclusionPeriod uint64                 `json:"forced_inclusion_period"`
 }
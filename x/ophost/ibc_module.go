@@ -148,9 +148,19 @@ func (im IBCModule) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	// currently L1 ophost module doesn't expect to receive packets
-	err := fmt.Errorf("ophost module on L1 should not receive packets")
-	return channeltypes.NewErrorAcknowledgement(err)
+	data, err := types.DecodeOPinitPacketData(packet.GetData())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	} else if data.L2StatusReport == nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("ophost module on L1 only receives l2 status reports"))
+	}
+
+	ackBytes, err := im.keeper.OnRecvL2StatusReportPacket(ctx, packet.GetDestChannel(), *data.L2StatusReport)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(ackBytes)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -166,6 +176,16 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ophost packet acknowledgement: %v", err)
 	}
 
+	// attestor set update packets are sent without the envelope
+	if data, err := types.DecodeOPinitPacketData(packet.GetData()); err == nil {
+		switch {
+		case data.OraclePriceUpdate != nil:
+			return im.keeper.OnAcknowledgeOraclePriceUpdatePacket(ctx, *data.OraclePriceUpdate, ack)
+		case data.ForcedTx != nil:
+			return im.keeper.OnAcknowledgeForcedTxPacket(ctx, *data.ForcedTx, ack)
+		}
+	}
+
 	switch resp := ack.GetResponse().(type) {
 	case *channeltypes.Acknowledgement_Error:
 		ctx.EventManager().EmitEvent(
@@ -187,5 +207,14 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	if data, err := types.DecodeOPinitPacketData(packet.GetData()); err == nil {
+		switch {
+		case data.OraclePriceUpdate != nil:
+			return im.keeper.OnTimeoutOraclePriceUpdatePacket(ctx, *data.OraclePriceUpdate)
+		case data.ForcedTx != nil:
+			return im.keeper.OnTimeoutForcedTxPacket(ctx, *data.ForcedTx)
+		}
+	}
+
 	return nil
 }
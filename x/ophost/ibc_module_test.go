@@ -1,6 +1,7 @@
 package ophost_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -87,11 +88,68 @@ func Test_OPHostIBCModule_OnRecvPacket(t *testing.T) {
 		Sequence:           1,
 	}
 
-	// OPHost module on L1 should not receive packets
+	// OPHost module on L1 only receives l2 status reports
 	ack := ibcModule.OnRecvPacket(ctx, ophosttypes.Version, packet, nil)
 	require.False(t, ack.Success())
 }
 
+func Test_OPHostIBCModule_OnRecvPacket_L2StatusReport(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(50)
+
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, ophosttypes.BridgeConfig{
+		Proposer:   testutil.AddrsStr[0],
+		Challenger: testutil.AddrsStr[1],
+		ChannelId:  "channel-1",
+	}))
+
+	ibcModule := ophost.NewIBCModule(input.OPHostKeeper)
+
+	packetData := ophosttypes.NewL2StatusReportPacket(ophosttypes.L2StatusReportPacketData{
+		BridgeId: 1,
+		Validators: []ophosttypes.L2Validator{
+			{Moniker: "val1", OperatorAddress: testutil.ValAddrsStr[0], ConsPower: 1},
+		},
+		NextL2Sequence: 10,
+		L2BlockHeight:  100,
+		L2BlockTime:    1000000000,
+	})
+
+	packet := channeltypes.Packet{
+		SourcePort:         ophosttypes.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    ophosttypes.PortID,
+		DestinationChannel: "channel-1",
+		Data:               packetData.GetBytes(),
+		Sequence:           1,
+	}
+
+	ack := ibcModule.OnRecvPacket(ctx, ophosttypes.Version, packet, nil)
+	require.True(t, ack.Success())
+
+	var packetAck ophosttypes.L2StatusReportPacketAck
+	err := json.Unmarshal(ack.(channeltypes.Acknowledgement).GetResult(), &packetAck)
+	require.NoError(t, err)
+	require.True(t, packetAck.Success, packetAck.Error)
+
+	l2Status, err := input.OPHostKeeper.GetL2Status(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), l2Status.NextL2Sequence)
+	require.Equal(t, uint64(100), l2Status.L2BlockHeight)
+	require.Equal(t, uint64(50), l2Status.L1BlockHeight)
+	require.Len(t, l2Status.Validators, 1)
+
+	// reports from other channels are rejected
+	packet.DestinationChannel = "channel-2"
+	ack = ibcModule.OnRecvPacket(ctx, ophosttypes.Version, packet, nil)
+	require.True(t, ack.Success())
+
+	err = json.Unmarshal(ack.(channeltypes.Acknowledgement).GetResult(), &packetAck)
+	require.NoError(t, err)
+	require.False(t, packetAck.Success)
+	require.Contains(t, packetAck.Error, "channel mismatch")
+}
+
 func Test_OPHostIBCModule_OnAcknowledgementPacket(t *testing.T) {
 	ctx, input := testutil.CreateTestInput(t, false)
 
@@ -163,3 +221,60 @@ func Test_OPHostIBCModule_OnTimeoutPacket(t *testing.T) {
 	err := ibcModule.OnTimeoutPacket(ctx, ophosttypes.Version, packet, nil)
 	require.NoError(t, err)
 }
+
+func Test_OPHostIBCModule_OnAcknowledgementPacket_OraclePriceUpdate(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	ibcModule := ophost.NewIBCModule(input.OPHostKeeper)
+
+	packetData := ophosttypes.NewOraclePriceUpdatePacket(ophosttypes.OraclePriceUpdatePacketData{
+		BridgeId: 1,
+		Prices: []ophosttypes.OraclePrice{
+			{CurrencyPairId: 0, CurrencyPair: "BTC/USD", Price: "10000000", Timestamp: 1000000000},
+		},
+		L1BlockHeight: 100,
+		L1BlockTime:   1000000000,
+	})
+
+	packet := channeltypes.Packet{
+		SourcePort:         ophosttypes.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    ophosttypes.PortID,
+		DestinationChannel: "channel-1",
+		Data:               packetData.GetBytes(),
+		Sequence:           1,
+	}
+
+	ackBz, err := json.Marshal(ophosttypes.OraclePriceUpdatePacketAck{Success: false, Error: "oracle is disabled"})
+	require.NoError(t, err)
+	ack := channeltypes.NewResultAcknowledgement(ackBz)
+
+	err = ibcModule.OnAcknowledgementPacket(ctx, ophosttypes.Version, packet, ack.Acknowledgement(), nil)
+	require.NoError(t, err)
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != ophosttypes.EventTypeOraclePricePacketAck {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == ophosttypes.AttributeKeySuccess {
+				require.Equal(t, "false", attr.Value)
+				found = true
+			}
+		}
+	}
+	require.True(t, found, "expected oracle price packet ack event to be emitted")
+
+	// timeout clears the push height of the bridge
+	err = input.OPHostKeeper.OraclePushHeights.Set(ctx, 1, 100)
+	require.NoError(t, err)
+
+	err = ibcModule.OnTimeoutPacket(ctx, ophosttypes.Version, packet, nil)
+	require.NoError(t, err)
+
+	has, err := input.OPHostKeeper.OraclePushHeights.Has(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, has)
+}
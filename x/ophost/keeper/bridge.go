@@ -21,7 +21,11 @@ func (k Keeper) SetBridgeConfig(
 		return err
 	}
 
-	return k.BridgeConfigs.Set(ctx, bridgeId, bridgeConfig)
+	if err := k.BridgeConfigs.Set(ctx, bridgeId, bridgeConfig); err != nil {
+		return err
+	}
+
+	return k.rescheduleOraclePush(ctx, bridgeId, bridgeConfig)
 }
 
 func (k Keeper) GetBridgeConfig(
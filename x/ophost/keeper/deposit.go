@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+)
+
+func (k Keeper) SetDepositCommitment(ctx context.Context, bridgeId, l1Sequence uint64, commitment []byte) error {
+	return k.DepositCommitments.Set(ctx, collections.Join(bridgeId, l1Sequence), commitment)
+}
+
+func (k Keeper) GetDepositCommitment(ctx context.Context, bridgeId, l1Sequence uint64) ([]byte, error) {
+	return k.DepositCommitments.Get(ctx, collections.Join(bridgeId, l1Sequence))
+}
+
+func (k Keeper) IterateDepositCommitments(
+	ctx context.Context,
+	bridgeId uint64,
+	cb func(l1Sequence uint64, commitment []byte) (bool, error),
+) error {
+	return k.DepositCommitments.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](bridgeId), func(key collections.Pair[uint64, uint64], commitment []byte) (stop bool, err error) {
+		return cb(key.K2(), commitment)
+	})
+}
+
+// pruneDepositCommitments removes the commitments of the deposits below the next l1 sequence
+// reported by L2, which are already finalized and no longer need to be proven. The sequence is
+// capped to the next l1 sequence of the bridge.
+func (k Keeper) pruneDepositCommitments(ctx context.Context, bridgeId, nextL1Sequence uint64) error {
+	bridgeNextL1Sequence, err := k.GetNextL1Sequence(ctx, bridgeId)
+	if err != nil {
+		return err
+	}
+
+	ranger := collections.NewPrefixedPairRange[uint64, uint64](bridgeId).EndExclusive(min(nextL1Sequence, bridgeNextL1Sequence))
+	return k.DepositCommitments.Clear(ctx, ranger)
+}
@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+////////////////////////////////////
+// NextForcedTxSequence
+
+func (k Keeper) SetNextForcedTxSequence(ctx context.Context, bridgeId, nextSequence uint64) error {
+	return k.NextForcedTxSeqs.Set(ctx, bridgeId, nextSequence)
+}
+
+func (k Keeper) GetNextForcedTxSequence(ctx context.Context, bridgeId uint64) (uint64, error) {
+	nextSequence, err := k.NextForcedTxSeqs.Get(ctx, bridgeId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			nextSequence = types.DefaultForcedTxSequenceStart
+		} else {
+			return 0, err
+		}
+	}
+
+	return nextSequence, nil
+}
+
+func (k Keeper) IncreaseNextForcedTxSequence(ctx context.Context, bridgeId uint64) (uint64, error) {
+	nextSequence, err := k.GetNextForcedTxSequence(ctx, bridgeId)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = k.NextForcedTxSeqs.Set(ctx, bridgeId, nextSequence+1); err != nil {
+		return 0, err
+	}
+
+	return nextSequence, nil
+}
+
+////////////////////////////////////
+// ForcedTx
+
+func (k Keeper) SetForcedTx(ctx context.Context, forcedTx types.ForcedTx) error {
+	return k.ForcedTxs.Set(ctx, collections.Join(forcedTx.BridgeId, forcedTx.Sequence), forcedTx)
+}
+
+func (k Keeper) GetForcedTx(ctx context.Context, bridgeId, sequence uint64) (types.ForcedTx, error) {
+	return k.ForcedTxs.Get(ctx, collections.Join(bridgeId, sequence))
+}
+
+func (k Keeper) IterateForcedTxs(
+	ctx context.Context,
+	bridgeId uint64,
+	cb func(forcedTx types.ForcedTx) (stop bool, err error),
+) error {
+	return k.ForcedTxs.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](bridgeId), func(_ collections.Pair[uint64, uint64], forcedTx types.ForcedTx) (stop bool, err error) {
+		return cb(forcedTx)
+	})
+}
+
+// SubmitForcedTx queues the L2 tx submitted on L1 and delivers it to L2 over the opinit channel.
+func (k Keeper) SubmitForcedTx(ctx context.Context, bridgeId uint64, sender string, tx []byte) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	config, err := k.GetBridgeConfig(ctx, bridgeId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, types.ErrBridgeNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	if config.BridgeDisabled {
+		return 0, types.ErrBridgeDisabled
+	} else if config.ForcedInclusionPeriod == 0 {
+		return 0, types.ErrForcedInclusionDisabled
+	} else if config.ChannelId == "" {
+		return 0, types.ErrForcedInclusionDisabled.Wrap("opinit channel is not registered")
+	}
+
+	// the forced tx fee bounds the forced txs flooding the L2 blocks
+	if fee := k.ForcedTxFee(ctx); fee.IsValid() {
+		senderAddr, err := k.authKeeper.AddressCodec().StringToBytes(sender)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, fee, senderAddr); err != nil {
+			return 0, err
+		}
+	}
+
+	sequence, err := k.IncreaseNextForcedTxSequence(ctx, bridgeId)
+	if err != nil {
+		return 0, err
+	}
+
+	forcedTx := types.ForcedTx{
+		BridgeId:      bridgeId,
+		Sequence:      sequence,
+		Sender:        sender,
+		Tx:            tx,
+		L1BlockHeight: uint64(sdkCtx.BlockHeight()), //nolint:gosec
+	}
+	if err := k.SetForcedTx(ctx, forcedTx); err != nil {
+		return 0, err
+	}
+
+	if err := k.SendForcedTxPacket(ctx, types.PortID, config.ChannelId, forcedTx); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubmitForcedTx,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyForcedTxSequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+	))
+
+	return sequence, nil
+}
+
+// SendForcedTxPacket sends a forced tx packet to L2 via IBC.
+func (k Keeper) SendForcedTxPacket(
+	ctx context.Context,
+	sourcePort, sourceChannel string,
+	forcedTx types.ForcedTx,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	packetData := types.NewForcedTxPacket(forcedTx)
+	timeoutTimestamp := uint64(sdkCtx.BlockTime().Add(types.DefaultPacketTimeoutTimestamp).UnixNano()) //nolint:gosec
+
+	if _, err := k.channelKeeper.SendPacket(
+		sdkCtx,
+		sourcePort,
+		sourceChannel,
+		types.DefaultTransferPacketTimeoutHeight,
+		timeoutTimestamp,
+		packetData.GetBytes(),
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to send IBC packet")
+	}
+
+	return nil
+}
+
+// OnAcknowledgeForcedTxPacket records the L2 height at which the forced tx was received, and
+// prunes the forced txs processed on L2. A forced tx
+// rejected by L2 before it is processed is sent again, so L2 never sees a gap in the sequence.
+func (k Keeper) OnAcknowledgeForcedTxPacket(
+	ctx context.Context,
+	data types.ForcedTxPacketData,
+	ack channeltypes.Acknowledgement,
+) error {
+	var packetAck types.ForcedTxPacketAck
+	switch resp := ack.GetResponse().(type) {
+	case *channeltypes.Acknowledgement_Result:
+		if err := json.Unmarshal(resp.Result, &packetAck); err != nil {
+			return errorsmod.Wrap(err, "failed to unmarshal forced tx packet ack")
+		}
+	case *channeltypes.Acknowledgement_Error:
+		packetAck.Error = resp.Error
+	}
+
+	if err := k.pruneForcedTxs(ctx, data.BridgeId, packetAck.NextForcedTxSequence); err != nil {
+		return err
+	}
+
+	forcedTx, err := k.GetForcedTx(ctx, data.BridgeId, data.Sequence)
+	if errors.Is(err, collections.ErrNotFound) {
+		// already processed on L2 and pruned
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if packetAck.Success {
+		forcedTx.L2ReceivedHeight = packetAck.L2BlockHeight
+		err = k.SetForcedTx(ctx, forcedTx)
+	} else {
+		err = k.resendForcedTx(ctx, forcedTx)
+	}
+	if err != nil {
+		return err
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(data.BridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyForcedTxSequence, strconv.FormatUint(data.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(packetAck.Success)),
+	}
+	if packetAck.Success {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyL2BlockHeight, strconv.FormatUint(packetAck.L2BlockHeight, 10)))
+	} else {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyAckError, packetAck.Error))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(types.EventTypeForcedTxPacketAck, attrs...))
+
+	return nil
+}
+
+// OnTimeoutForcedTxPacket sends the forced tx again, so the tx cannot be dropped by not relaying it.
+func (k Keeper) OnTimeoutForcedTxPacket(
+	ctx context.Context,
+	data types.ForcedTxPacketData,
+) error {
+	forcedTx, err := k.GetForcedTx(ctx, data.BridgeId, data.Sequence)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.resendForcedTx(ctx, forcedTx); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeForcedTxTimeout,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(data.BridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyForcedTxSequence, strconv.FormatUint(data.Sequence, 10)),
+	))
+
+	return nil
+}
+
+// resendForcedTx sends the queued forced tx again over the opinit channel of the bridge.
+func (k Keeper) resendForcedTx(ctx context.Context, forcedTx types.ForcedTx) error {
+	config, err := k.GetBridgeConfig(ctx, forcedTx.BridgeId)
+	if err != nil {
+		return err
+	}
+
+	return k.SendForcedTxPacket(ctx, types.PortID, config.ChannelId, forcedTx)
+}
+
+// pruneForcedTxs removes the forced txs which are processed on L2, i.e. the sequence is lower
+// than the next forced tx sequence reported by L2.
+func (k Keeper) pruneForcedTxs(ctx context.Context, bridgeId, nextForcedTxSequence uint64) error {
+	ranger := collections.NewPrefixedPairRange[uint64, uint64](bridgeId).EndExclusive(nextForcedTxSequence)
+	return k.ForcedTxs.Clear(ctx, ranger)
+}
+
+// checkForcedInclusion rejects an output proposed after the forced inclusion deadline of a forced
+// tx which L2 has not proven as processed. The deadline is counted in L1 blocks from the submission
+// of the tx on L1, and the forced tx is pruned once the L2 status report or the forced tx
+// acknowledgement carries a next forced tx sequence beyond it.
+func (k Keeper) checkForcedInclusion(ctx context.Context, bridgeId uint64, config types.BridgeConfig) error {
+	if config.ForcedInclusionPeriod == 0 {
+		return nil
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	return k.IterateForcedTxs(ctx, bridgeId, func(forcedTx types.ForcedTx) (stop bool, err error) {
+		deadline := forcedTx.L1BlockHeight + config.ForcedInclusionPeriod
+		if height > deadline {
+			return true, types.ErrForcedInclusionViolated.Wrapf(
+				"forced tx %d must be processed on L2 by l1 block %d", forcedTx.Sequence, deadline,
+			)
+		}
+
+		// the forced txs are submitted in the order of the sequence, so the first pending
+		// forced tx has the earliest deadline
+		return true, nil
+	})
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/initia-labs/OPinit/x/ophost/keeper"
+	"github.com/initia-labs/OPinit/x/ophost/testutil"
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func forcedTxAck(t *testing.T, ack types.ForcedTxPacketAck) channeltypes.Acknowledgement {
+	bz, err := json.Marshal(ack)
+	require.NoError(t, err)
+
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+func Test_ForcedInclusion(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+
+	config := types.BridgeConfig{
+		Proposer:              testutil.AddrsStr[0],
+		Challenger:            testutil.AddrsStr[1],
+		SubmissionInterval:    time.Second * 10,
+		FinalizationPeriod:    time.Second * 60,
+		SubmissionStartHeight: 1,
+		BatchInfo:             types.BatchInfo{Submitter: testutil.AddrsStr[0], ChainType: types.BatchInfo_INITIA},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(testutil.AddrsStr[0], config))
+	require.NoError(t, err)
+
+	// forced inclusion is disabled by default
+	_, err = ms.SubmitForcedTx(ctx, types.NewMsgSubmitForcedTx(testutil.AddrsStr[2], 1, []byte{1, 2, 3}))
+	require.ErrorIs(t, err, types.ErrForcedInclusionDisabled)
+
+	govAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	// unauthorized
+	_, err = ms.UpdateForcedInclusionPeriod(ctx, types.NewMsgUpdateForcedInclusionPeriod(testutil.AddrsStr[0], 1, 10))
+	require.Error(t, err)
+
+	_, err = ms.UpdateForcedInclusionPeriod(ctx, types.NewMsgUpdateForcedInclusionPeriod(govAddr, 1, 10))
+	require.NoError(t, err)
+
+	// the opinit channel is not registered yet
+	_, err = ms.SubmitForcedTx(ctx, types.NewMsgSubmitForcedTx(testutil.AddrsStr[2], 1, []byte{1, 2, 3}))
+	require.ErrorIs(t, err, types.ErrForcedInclusionDisabled)
+
+	config, err = input.OPHostKeeper.GetBridgeConfig(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), config.ForcedInclusionPeriod)
+	config.ChannelId = "channel-0"
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, config))
+
+	// the forced tx fee is paid to the community pool
+	params := input.OPHostKeeper.GetParams(ctx)
+	params.ForcedTxFee = sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(10)))
+	require.NoError(t, input.OPHostKeeper.SetParams(ctx, params))
+
+	// submit two forced txs
+	ctx = ctx.WithBlockHeight(5)
+	res, err := ms.SubmitForcedTx(ctx, types.NewMsgSubmitForcedTx(testutil.AddrsStr[2], 1, []byte{1, 2, 3}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Sequence)
+	res, err = ms.SubmitForcedTx(ctx, types.NewMsgSubmitForcedTx(testutil.AddrsStr[3], 1, []byte{4, 5, 6}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Sequence)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(20))), input.CommunityPoolKeeper.CommunityPool)
+
+	require.Len(t, input.ChannelKeeper.SentPackets, 2)
+	sent := input.ChannelKeeper.SentPackets[0]
+	require.Equal(t, types.PortID, sent.SourcePort)
+	require.Equal(t, "channel-0", sent.SourceChannel)
+
+	packetData, err := types.DecodeOPinitPacketData(sent.Data)
+	require.NoError(t, err)
+	require.NotNil(t, packetData.ForcedTx)
+	require.Equal(t, types.ForcedTxPacketData{
+		BridgeId:      1,
+		Sequence:      1,
+		Sender:        testutil.AddrsStr[2],
+		Tx:            []byte{1, 2, 3},
+		L1BlockHeight: 5,
+	}, *packetData.ForcedTx)
+
+	// the timed out packet is sent again
+	require.NoError(t, input.OPHostKeeper.OnTimeoutForcedTxPacket(ctx, *packetData.ForcedTx))
+	require.Len(t, input.ChannelKeeper.SentPackets, 3)
+
+	// forced txs within the forced inclusion period do not restrict the output
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(testutil.AddrsStr[0], 1, 1, 100, make([]byte, 32)))
+	require.NoError(t, err)
+
+	// L2 received the first forced tx at 100 and rejected the second one before processing it
+	require.NoError(t, input.OPHostKeeper.OnAcknowledgeForcedTxPacket(ctx, *packetData.ForcedTx, forcedTxAck(t, types.ForcedTxPacketAck{
+		Success:              true,
+		L2BlockHeight:        100,
+		NextForcedTxSequence: 1,
+	})))
+	require.NoError(t, input.OPHostKeeper.OnAcknowledgeForcedTxPacket(ctx, types.ForcedTxPacketData{BridgeId: 1, Sequence: 2}, forcedTxAck(t, types.ForcedTxPacketAck{
+		Success:              false,
+		Error:                "failed to get bridge info",
+		NextForcedTxSequence: 1,
+	})))
+
+	// the rejected forced tx is sent again, so L2 does not see a gap in the sequence
+	require.Len(t, input.ChannelKeeper.SentPackets, 4)
+
+	forcedTxs, err := keeper.NewQuerier(input.OPHostKeeper).ForcedTxs(ctx, &types.QueryForcedTxsRequest{BridgeId: 1})
+	require.NoError(t, err)
+	require.Len(t, forcedTxs.ForcedTxs, 2)
+	require.Equal(t, uint64(100), forcedTxs.ForcedTxs[0].L2ReceivedHeight)
+	require.Equal(t, uint64(0), forcedTxs.ForcedTxs[1].L2ReceivedHeight)
+
+	// an output at the deadline, which is counted from the L1 submission
+	ctx = ctx.WithBlockHeight(15)
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(testutil.AddrsStr[0], 1, 2, 110, make([]byte, 32)))
+	require.NoError(t, err)
+
+	// the output after the deadline is rejected, even if L2 does not report its status
+	ctx = ctx.WithBlockHeight(16)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = ms.ProposeOutput(cacheCtx, types.NewMsgProposeOutput(testutil.AddrsStr[0], 1, 3, 111, make([]byte, 32)))
+	require.ErrorIs(t, err, types.ErrForcedInclusionViolated)
+
+	// L2 reports the forced tx as not processed
+	ack, err := input.OPHostKeeper.HandleL2StatusReportPacket(ctx, "channel-0", types.L2StatusReportPacketData{
+		BridgeId:             1,
+		L2BlockHeight:        111,
+		NextForcedTxSequence: 1,
+	})
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = ms.ProposeOutput(cacheCtx, types.NewMsgProposeOutput(testutil.AddrsStr[0], 1, 3, 111, make([]byte, 32)))
+	require.ErrorIs(t, err, types.ErrForcedInclusionViolated)
+
+	// L2 acknowledges the resent forced tx after processing the first one
+	require.NoError(t, input.OPHostKeeper.OnAcknowledgeForcedTxPacket(ctx, types.ForcedTxPacketData{BridgeId: 1, Sequence: 2}, forcedTxAck(t, types.ForcedTxPacketAck{
+		Success:              true,
+		L2BlockHeight:        112,
+		NextForcedTxSequence: 2,
+	})))
+
+	_, err = input.OPHostKeeper.GetForcedTx(ctx, 1, 1)
+	require.Error(t, err)
+
+	forcedTx, err := input.OPHostKeeper.GetForcedTx(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(112), forcedTx.L2ReceivedHeight)
+
+	// the second forced tx is not proven as processed yet
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = ms.ProposeOutput(cacheCtx, types.NewMsgProposeOutput(testutil.AddrsStr[0], 1, 3, 111, make([]byte, 32)))
+	require.ErrorIs(t, err, types.ErrForcedInclusionViolated)
+
+	// the processed forced tx is pruned by the next forced tx sequence of the ack
+	require.NoError(t, input.OPHostKeeper.OnAcknowledgeForcedTxPacket(ctx, types.ForcedTxPacketData{BridgeId: 1, Sequence: 2}, forcedTxAck(t, types.ForcedTxPacketAck{
+		Success:              false,
+		Error:                "forced tx 2 is already processed",
+		NextForcedTxSequence: 3,
+	})))
+
+	_, err = input.OPHostKeeper.GetForcedTx(ctx, 1, 2)
+	require.Error(t, err)
+	require.Len(t, input.ChannelKeeper.SentPackets, 4)
+
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(testutil.AddrsStr[0], 1, 3, 111, make([]byte, 32)))
+	require.NoError(t, err)
+}
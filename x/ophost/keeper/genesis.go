@@ -51,6 +51,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 				panic(err)
 			}
 		}
+
+		for _, depositCommitment := range bridge.DepositCommitments {
+			if err := k.SetDepositCommitment(ctx, bridgeId, depositCommitment.Sequence, depositCommitment.Commitment); err != nil {
+				panic(err)
+			}
+		}
+
+		for _, forcedTx := range bridge.ForcedTxs {
+			if err := k.SetForcedTx(ctx, forcedTx); err != nil {
+				panic(err)
+			}
+		}
+
+		if bridge.NextForcedTxSequence != 0 {
+			if err := k.SetNextForcedTxSequence(ctx, bridgeId, bridge.NextForcedTxSequence); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	for _, migrationInfo := range data.MigrationInfos {
@@ -59,6 +77,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		}
 	}
 
+	for _, migrationStatus := range data.MigrationStatuses {
+		if err := k.MigrationStatuses.Set(ctx, collections.Join(migrationStatus.BridgeId, migrationStatus.L1Denom), migrationStatus); err != nil {
+			panic(err)
+		}
+	}
+
 	if err := k.SetNextBridgeId(ctx, data.NextBridgeId); err != nil {
 		panic(err)
 	}
@@ -116,15 +140,43 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			return true, err
 		}
 
+		var depositCommitments []types.DepositCommitment
+		if err := k.IterateDepositCommitments(ctx, bridgeId, func(l1Sequence uint64, commitment []byte) (bool, error) {
+			depositCommitments = append(depositCommitments, types.DepositCommitment{
+				Sequence:   l1Sequence,
+				Commitment: commitment,
+			})
+
+			return false, nil
+		}); err != nil {
+			return true, err
+		}
+
+		var forcedTxs []types.ForcedTx
+		if err := k.IterateForcedTxs(ctx, bridgeId, func(forcedTx types.ForcedTx) (stop bool, err error) {
+			forcedTxs = append(forcedTxs, forcedTx)
+			return false, nil
+		}); err != nil {
+			return true, err
+		}
+
+		nextForcedTxSequence, err := k.GetNextForcedTxSequence(ctx, bridgeId)
+		if err != nil {
+			return true, err
+		}
+
 		bridges = append(bridges, types.Bridge{
-			BridgeId:          bridgeId,
-			NextL1Sequence:    nextL1Sequence,
-			NextOutputIndex:   nextOutputIndex,
-			BridgeConfig:      bridgeConfig,
-			TokenPairs:        tokenPairs,
-			ProvenWithdrawals: provenWithdrawals,
-			Proposals:         proposals,
-			BatchInfos:        batchInfos,
+			BridgeId:             bridgeId,
+			NextL1Sequence:       nextL1Sequence,
+			NextOutputIndex:      nextOutputIndex,
+			BridgeConfig:         bridgeConfig,
+			TokenPairs:           tokenPairs,
+			ProvenWithdrawals:    provenWithdrawals,
+			Proposals:            proposals,
+			BatchInfos:           batchInfos,
+			DepositCommitments:   depositCommitments,
+			ForcedTxs:            forcedTxs,
+			NextForcedTxSequence: nextForcedTxSequence,
 		})
 
 		return false, nil
@@ -146,10 +198,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	var migrationStatuses []types.MigrationStatus
+	if err := k.MigrationStatuses.Walk(ctx, nil, func(_ collections.Pair[uint64, string], migrationStatus types.MigrationStatus) (stop bool, err error) {
+		migrationStatuses = append(migrationStatuses, migrationStatus)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:         k.GetParams(ctx),
-		Bridges:        bridges,
-		NextBridgeId:   nextBridgeId,
-		MigrationInfos: migrationInfos,
+		Params:            k.GetParams(ctx),
+		Bridges:           bridges,
+		NextBridgeId:      nextBridgeId,
+		MigrationInfos:    migrationInfos,
+		MigrationStatuses: migrationStatuses,
 	}
 }
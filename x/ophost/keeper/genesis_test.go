@@ -122,6 +122,7 @@ func Test_GenesisExport(t *testing.T) {
 			{BatchInfo: types.BatchInfo{Submitter: testutil.AddrsStr[1], ChainType: types.BatchInfo_CELESTIA}, Output: output1},
 			{BatchInfo: types.BatchInfo{Submitter: testutil.AddrsStr[0], ChainType: types.BatchInfo_INITIA}, Output: output3},
 		},
+		NextForcedTxSequence: types.DefaultForcedTxSequenceStart,
 	}, genState.Bridges[0])
 
 	require.Equal(t, []types.MigrationInfo{
@@ -204,6 +205,14 @@ func Test_GenesisImportExport(t *testing.T) {
 					{BatchInfo: types.BatchInfo{Submitter: testutil.AddrsStr[1], ChainType: types.BatchInfo_CELESTIA}, Output: output1},
 					{BatchInfo: types.BatchInfo{Submitter: testutil.AddrsStr[0], ChainType: types.BatchInfo_INITIA}, Output: output3},
 				},
+				DepositCommitments: []types.DepositCommitment{
+					{Sequence: 1, Commitment: []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+					{Sequence: 2, Commitment: []byte{3, 4, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+				},
+				ForcedTxs: []types.ForcedTx{
+					{BridgeId: 1, Sequence: 2, Sender: testutil.AddrsStr[2], Tx: []byte{1, 2, 3}, L1BlockHeight: 10, L2ReceivedHeight: 20},
+				},
+				NextForcedTxSequence: 3,
 			}},
 		NextBridgeId: 2,
 		MigrationInfos: []types.MigrationInfo{
@@ -22,7 +22,7 @@ type Keeper struct {
 
 	authKeeper          types.AccountKeeper
 	bankKeeper          types.BankKeeper
-	bridgeHook          types.BridgeHook
+	bridgeHook          types.ExtendedBridgeHook
 	communityPoolKeeper types.CommunityPoolKeeper
 	channelKeeper       types.ChannelKeeper
 	oracleKeeper        types.OracleKeeper
@@ -34,18 +34,26 @@ type Keeper struct {
 
 	validatorAddressCodec address.Codec
 
-	Schema            collections.Schema
-	NextBridgeId      collections.Sequence
-	Params            collections.Item[types.Params]
-	BridgeConfigs     collections.Map[uint64, types.BridgeConfig]
-	BatchInfos        collections.Map[collections.Pair[uint64, uint64], types.BatchInfoWithOutput]
-	NextL1Sequences   collections.Map[uint64, uint64]
-	TokenPairs        collections.Map[collections.Pair[uint64, string], string]
-	OutputProposals   collections.Map[collections.Pair[uint64, uint64], types.Output]
-	NextOutputIndexes collections.Map[uint64, uint64]
-	ProvenWithdrawals collections.Map[collections.Pair[uint64, []byte], bool]
-	MigrationInfos    collections.Map[collections.Pair[uint64, string], types.MigrationInfo]
-	OraclePriceHash   collections.Item[types.OraclePriceHash]
+	Schema             collections.Schema
+	NextBridgeId       collections.Sequence
+	Params             collections.Item[types.Params]
+	BridgeConfigs      collections.Map[uint64, types.BridgeConfig]
+	BatchInfos         collections.Map[collections.Pair[uint64, uint64], types.BatchInfoWithOutput]
+	NextL1Sequences    collections.Map[uint64, uint64]
+	TokenPairs         collections.Map[collections.Pair[uint64, string], string]
+	OutputProposals    collections.Map[collections.Pair[uint64, uint64], types.Output]
+	NextOutputIndexes  collections.Map[uint64, uint64]
+	ProvenWithdrawals  collections.Map[collections.Pair[uint64, []byte], bool]
+	MigrationInfos     collections.Map[collections.Pair[uint64, string], types.MigrationInfo]
+	MigrationStatuses  collections.Map[collections.Pair[uint64, string], types.MigrationStatus]
+	OraclePriceHash    collections.Item[types.OraclePriceHash]
+	OraclePushHeights  collections.Map[uint64, uint64]                      // bridge id -> l1 height of the last oracle price push
+	NextOraclePushes   collections.Map[uint64, uint64]                      // bridge id -> l1 height of the next oracle price push
+	OraclePushQueue    collections.KeySet[collections.Pair[uint64, uint64]] // (l1 height of the next oracle price push, bridge id)
+	L2Statuses         collections.Map[uint64, types.L2Status]
+	DepositCommitments collections.Map[collections.Pair[uint64, uint64], []byte]         // (bridge id, l1 sequence) -> deposit commitment
+	ForcedTxs          collections.Map[collections.Pair[uint64, uint64], types.ForcedTx] // (bridge id, forced tx sequence) -> forced tx
+	NextForcedTxSeqs   collections.Map[uint64, uint64]
 }
 
 func NewKeeper(
@@ -81,22 +89,30 @@ func NewKeeper(
 		oracleKeeper:        oracleKeeper,
 		transferKeeper:      transferKeeper,
 
-		bridgeHook: bridgeHook,
+		bridgeHook: types.NewExtendedBridgeHook(bridgeHook),
 		authority:  authority,
 
 		validatorAddressCodec: validatorAddressCodec,
 
-		NextBridgeId:      collections.NewSequence(sb, types.NextBridgeIdKey, "next_bridge_id"),
-		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		BridgeConfigs:     collections.NewMap(sb, types.BridgeConfigPrefix, "bridge_configs", collections.Uint64Key, codec.CollValue[types.BridgeConfig](cdc)),
-		BatchInfos:        collections.NewMap(sb, types.BatchInfoPrefix, "batch_infos", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.BatchInfoWithOutput](cdc)),
-		NextL1Sequences:   collections.NewMap(sb, types.NextL1SequencePrefix, "next_l1_sequences", collections.Uint64Key, collections.Uint64Value),
-		TokenPairs:        collections.NewMap(sb, types.TokenPairPrefix, "token_pairs", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.StringValue),
-		OutputProposals:   collections.NewMap(sb, types.OutputProposalPrefix, "output_proposals", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.Output](cdc)),
-		NextOutputIndexes: collections.NewMap(sb, types.NextOutputIndexPrefix, "next_output_indexes", collections.Uint64Key, collections.Uint64Value),
-		ProvenWithdrawals: collections.NewMap(sb, types.ProvenWithdrawalPrefix, "proven_withdrawals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BoolValue),
-		MigrationInfos:    collections.NewMap(sb, types.MigrationInfoPrefix, "migration_infos", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.MigrationInfo](cdc)),
-		OraclePriceHash:   collections.NewItem(sb, types.OraclePriceHashPrefix, "oracle_price_hash", codec.CollValue[types.OraclePriceHash](cdc)),
+		NextBridgeId:       collections.NewSequence(sb, types.NextBridgeIdKey, "next_bridge_id"),
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BridgeConfigs:      collections.NewMap(sb, types.BridgeConfigPrefix, "bridge_configs", collections.Uint64Key, codec.CollValue[types.BridgeConfig](cdc)),
+		BatchInfos:         collections.NewMap(sb, types.BatchInfoPrefix, "batch_infos", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.BatchInfoWithOutput](cdc)),
+		NextL1Sequences:    collections.NewMap(sb, types.NextL1SequencePrefix, "next_l1_sequences", collections.Uint64Key, collections.Uint64Value),
+		TokenPairs:         collections.NewMap(sb, types.TokenPairPrefix, "token_pairs", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.StringValue),
+		OutputProposals:    collections.NewMap(sb, types.OutputProposalPrefix, "output_proposals", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.Output](cdc)),
+		NextOutputIndexes:  collections.NewMap(sb, types.NextOutputIndexPrefix, "next_output_indexes", collections.Uint64Key, collections.Uint64Value),
+		ProvenWithdrawals:  collections.NewMap(sb, types.ProvenWithdrawalPrefix, "proven_withdrawals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BoolValue),
+		MigrationInfos:     collections.NewMap(sb, types.MigrationInfoPrefix, "migration_infos", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.MigrationInfo](cdc)),
+		MigrationStatuses:  collections.NewMap(sb, types.MigrationStatusPrefix, "migration_statuses", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.MigrationStatus](cdc)),
+		OraclePriceHash:    collections.NewItem(sb, types.OraclePriceHashPrefix, "oracle_price_hash", codec.CollValue[types.OraclePriceHash](cdc)),
+		OraclePushHeights:  collections.NewMap(sb, types.OraclePushHeightPrefix, "oracle_push_heights", collections.Uint64Key, collections.Uint64Value),
+		NextOraclePushes:   collections.NewMap(sb, types.NextOraclePushPrefix, "next_oracle_pushes", collections.Uint64Key, collections.Uint64Value),
+		OraclePushQueue:    collections.NewKeySet(sb, types.OraclePushQueuePrefix, "oracle_push_queue", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		L2Statuses:         collections.NewMap(sb, types.L2StatusPrefix, "l2_statuses", collections.Uint64Key, codec.CollValue[types.L2Status](cdc)),
+		DepositCommitments: collections.NewMap(sb, types.DepositCommitmentPrefix, "deposit_commitments", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.BytesValue),
+		ForcedTxs:          collections.NewMap(sb, types.ForcedTxPrefix, "forced_txs", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.ForcedTx](cdc)),
+		NextForcedTxSeqs:   collections.NewMap(sb, types.NextForcedTxSeqPrefix, "next_forced_tx_sequences", collections.Uint64Key, collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// OnRecvL2StatusReportPacket handles the L2 status report packet received over the opinit channel
+// and returns the marshaled acknowledgement.
+func (k Keeper) OnRecvL2StatusReportPacket(
+	ctx context.Context,
+	destinationChannel string,
+	data types.L2StatusReportPacketData,
+) ([]byte, error) {
+	ack, err := k.HandleL2StatusReportPacket(ctx, destinationChannel, data)
+	if err != nil {
+		return nil, err
+	}
+
+	ackBytes, err := json.Marshal(ack)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, "failed to marshal acknowledgement")
+	}
+
+	return ackBytes, nil
+}
+
+// HandleL2StatusReportPacket stores the L2 status reported by the bridge. The report is only accepted
+// from the channel registered in the bridge config, and a report older than the stored one is rejected.
+func (k Keeper) HandleL2StatusReportPacket(
+	ctx context.Context,
+	destinationChannel string,
+	data types.L2StatusReportPacketData,
+) (types.L2StatusReportPacketAck, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	config, err := k.GetBridgeConfig(ctx, data.BridgeId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.L2StatusReportPacketAck{
+			Success: false,
+			Error:   types.ErrBridgeNotFound.Error(),
+		}, nil
+	} else if err != nil {
+		return types.L2StatusReportPacketAck{}, err
+	}
+
+	if config.ChannelId == "" || config.ChannelId != destinationChannel {
+		return types.L2StatusReportPacketAck{
+			Success: false,
+			Error:   fmt.Sprintf("channel mismatch: expected %s, got %s", config.ChannelId, destinationChannel),
+		}, nil
+	}
+
+	prevStatus, err := k.L2Statuses.Get(ctx, data.BridgeId)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.L2StatusReportPacketAck{}, err
+	} else if err == nil && data.L2BlockHeight <= prevStatus.L2BlockHeight {
+		return types.L2StatusReportPacketAck{
+			Success: false,
+			Error:   fmt.Sprintf("stale l2 status: latest l2 block height %d, got %d", prevStatus.L2BlockHeight, data.L2BlockHeight),
+		}, nil
+	}
+
+	withdrawalsFinished := config.BridgeDisabled && data.ShutdownInfo != nil && data.ShutdownInfo.LastBlock
+	status := types.L2Status{
+		Validators:           data.Validators,
+		NextL2Sequence:       data.NextL2Sequence,
+		ShutdownInfo:         data.ShutdownInfo,
+		L2BlockHeight:        data.L2BlockHeight,
+		L2BlockTime:          time.Unix(0, data.L2BlockTime).UTC(),
+		L1BlockHeight:        uint64(sdkCtx.BlockHeight()), //nolint:gosec
+		WithdrawalsFinished:  withdrawalsFinished,
+		NextForcedTxSequence: data.NextForcedTxSequence,
+		NextL1Sequence:       data.NextL1Sequence,
+	}
+	if err := k.L2Statuses.Set(ctx, data.BridgeId, status); err != nil {
+		return types.L2StatusReportPacketAck{}, err
+	}
+
+	// the forced txs below the reported sequence are processed on L2
+	if err := k.pruneForcedTxs(ctx, data.BridgeId, data.NextForcedTxSequence); err != nil {
+		return types.L2StatusReportPacketAck{}, err
+	}
+
+	// the deposits below the reported sequence are finalized on L2
+	if err := k.pruneDepositCommitments(ctx, data.BridgeId, data.NextL1Sequence); err != nil {
+		return types.L2StatusReportPacketAck{}, err
+	}
+
+	bridgeIdStr := strconv.FormatUint(data.BridgeId, 10)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeL2StatusReport,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, bridgeIdStr),
+		sdk.NewAttribute(types.AttributeKeyL2BlockHeight, strconv.FormatUint(data.L2BlockHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyNextL2Sequence, strconv.FormatUint(data.NextL2Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyNumValidators, strconv.Itoa(len(data.Validators))),
+		sdk.NewAttribute(types.AttributeKeyNextForcedTxSequence, strconv.FormatUint(data.NextForcedTxSequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyNextL1Sequence, strconv.FormatUint(data.NextL1Sequence, 10)),
+	))
+
+	// notify once when the disabled bridge has withdrawn all the balances
+	if status.WithdrawalsFinished && !prevStatus.WithdrawalsFinished {
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeWithdrawalsFinished,
+			sdk.NewAttribute(types.AttributeKeyBridgeId, bridgeIdStr),
+			sdk.NewAttribute(types.AttributeKeyNextL2Sequence, strconv.FormatUint(data.NextL2Sequence, 10)),
+		))
+	}
+
+	return types.L2StatusReportPacketAck{Success: true}, nil
+}
+
+// GetL2Status returns the latest L2 status reported by the bridge.
+func (k Keeper) GetL2Status(ctx context.Context, bridgeId uint64) (types.L2Status, error) {
+	return k.L2Statuses.Get(ctx, bridgeId)
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/ophost/testutil"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_HandleL2StatusReportPacket(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(50)
+
+	config := ophosttypes.BridgeConfig{
+		Proposer:   testutil.AddrsStr[0],
+		Challenger: testutil.AddrsStr[1],
+		ChannelId:  "channel-0",
+	}
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, config))
+
+	report := ophosttypes.L2StatusReportPacketData{
+		BridgeId: 1,
+		Validators: []ophosttypes.L2Validator{
+			{Moniker: "val1", OperatorAddress: testutil.ValAddrsStr[0], ConsPower: 1},
+		},
+		NextL2Sequence: 5,
+		L2BlockHeight:  100,
+		L2BlockTime:    time.Unix(1000, 0).UnixNano(),
+	}
+
+	ack, err := input.OPHostKeeper.HandleL2StatusReportPacket(ctx, "channel-0", report)
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+
+	l2Status, err := input.OPHostKeeper.GetL2Status(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ophosttypes.L2Status{
+		Validators:     report.Validators,
+		NextL2Sequence: 5,
+		L2BlockHeight:  100,
+		L2BlockTime:    time.Unix(1000, 0).UTC(),
+		L1BlockHeight:  50,
+	}, l2Status)
+
+	// stale report
+	ack, err = input.OPHostKeeper.HandleL2StatusReportPacket(ctx, "channel-0", report)
+	require.NoError(t, err)
+	require.False(t, ack.Success)
+
+	// unknown bridge
+	report.BridgeId = 2
+	ack, err = input.OPHostKeeper.HandleL2StatusReportPacket(ctx, "channel-0", report)
+	require.NoError(t, err)
+	require.False(t, ack.Success)
+
+	_, err = input.OPHostKeeper.GetL2Status(ctx, 2)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func Test_HandleL2StatusReportPacket_WithdrawalsFinished(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	config := ophosttypes.BridgeConfig{
+		Proposer:   testutil.AddrsStr[0],
+		Challenger: testutil.AddrsStr[1],
+		ChannelId:  "channel-0",
+	}
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, config))
+
+	// shutdown is finished, but the bridge is not disabled on L1
+	report := ophosttypes.L2StatusReportPacketData{
+		BridgeId:      1,
+		ShutdownInfo:  &ophosttypes.L2ShutdownInfo{LastBlock: true},
+		L2BlockHeight: 100,
+	}
+	ack, err := input.OPHostKeeper.HandleL2StatusReportPacket(ctx, "channel-0", report)
+	require.NoError(t, err)
+	require.True(t, ack.Success)
+
+	l2Status, err := input.OPHostKeeper.GetL2Status(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, l2Status.WithdrawalsFinished)
+
+	// shutdown in progress
+	config.BridgeDisabled = true
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, config))
+
+	report.ShutdownInfo = &ophosttypes.L2ShutdownInfo{LastAddr: testutil.Addrs[0], LastBlock: false}
+	report.L2BlockHeight = 101
+	ack, err = input.OPHostKeeper.HandleL2StatusReportPacket(ctx, "channel-0", report)
+	require.NoError(t, err)
+	require.True(t, ack.Success)
+
+	l2Status, err = input.OPHostKeeper.GetL2Status(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, l2Status.WithdrawalsFinished)
+
+	// shutdown finished
+	report.ShutdownInfo = &ophosttypes.L2ShutdownInfo{LastAddr: testutil.Addrs[1], LastBlock: true}
+	report.L2BlockHeight = 102
+	ack, err = input.OPHostKeeper.HandleL2StatusReportPacket(ctx, "channel-0", report)
+	require.NoError(t, err)
+	require.True(t, ack.Success)
+
+	l2Status, err = input.OPHostKeeper.GetL2Status(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, l2Status.WithdrawalsFinished)
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == ophosttypes.EventTypeWithdrawalsFinished {
+			found = true
+		}
+	}
+	require.True(t, found, "expected withdrawals finished event to be emitted")
+}
+
+func Test_HandleL2StatusReportPacket_PruneDepositCommitments(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	config := ophosttypes.BridgeConfig{
+		Proposer:   testutil.AddrsStr[0],
+		Challenger: testutil.AddrsStr[1],
+		ChannelId:  "channel-0",
+	}
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, config))
+	require.NoError(t, input.OPHostKeeper.SetNextL1Sequence(ctx, 1, 4))
+	for sequence := uint64(1); sequence < 4; sequence++ {
+		require.NoError(t, input.OPHostKeeper.SetDepositCommitment(ctx, 1, sequence, []byte("commitment")))
+	}
+
+	ack, err := input.OPHostKeeper.HandleL2StatusReportPacket(ctx, "channel-0", ophosttypes.L2StatusReportPacketData{
+		BridgeId:       1,
+		L2BlockHeight:  100,
+		NextL1Sequence: 3,
+	})
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+
+	// the commitments of the finalized deposits are pruned
+	for sequence := uint64(1); sequence < 3; sequence++ {
+		_, err = input.OPHostKeeper.GetDepositCommitment(ctx, 1, sequence)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+	_, err = input.OPHostKeeper.GetDepositCommitment(ctx, 1, 3)
+	require.NoError(t, err)
+
+	// the reported sequence is capped to the next l1 sequence of the bridge
+	ack, err = input.OPHostKeeper.HandleL2StatusReportPacket(ctx, "channel-0", ophosttypes.L2StatusReportPacketData{
+		BridgeId:       1,
+		L2BlockHeight:  101,
+		NextL1Sequence: 10,
+	})
+	require.NoError(t, err)
+	require.True(t, ack.Success, ack.Error)
+
+	_, err = input.OPHostKeeper.GetDepositCommitment(ctx, 1, 3)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
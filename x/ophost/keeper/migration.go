@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +49,35 @@ func (k Keeper) IterateMigrationInfos(ctx context.Context, cb func(key collectio
 	})
 }
 
+// GetMigrationStatus returns the migration counters of the l1 denom, which are zero before the
+// registration.
+func (k Keeper) GetMigrationStatus(ctx context.Context, bridgeId uint64, l1Denom string) (types.MigrationStatus, error) {
+	migrationStatus, err := k.MigrationStatuses.Get(ctx, collections.Join(bridgeId, l1Denom))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.NewMigrationStatus(bridgeId, l1Denom), nil
+	} else if err != nil {
+		return types.MigrationStatus{}, err
+	}
+
+	// the statuses stored before the paused deposits were tracked have no paused deposit amount
+	if migrationStatus.PausedDeposited.Amount.IsNil() {
+		migrationStatus.PausedDeposited = sdk.NewCoin(l1Denom, math.ZeroInt())
+	}
+
+	return migrationStatus, nil
+}
+
+// updateMigrationStatus applies the update function to the migration counters of the l1 denom.
+func (k Keeper) updateMigrationStatus(ctx context.Context, bridgeId uint64, l1Denom string, update func(*types.MigrationStatus)) error {
+	migrationStatus, err := k.GetMigrationStatus(ctx, bridgeId, l1Denom)
+	if err != nil {
+		return err
+	}
+
+	update(&migrationStatus)
+	return k.MigrationStatuses.Set(ctx, collections.Join(bridgeId, l1Denom), migrationStatus)
+}
+
 // HandleMigratedTokenDeposit handles the migrated token deposit by forwarding it to ibc transfer module
 func (k Keeper) HandleMigratedTokenDeposit(ctx context.Context, msg *types.MsgInitiateTokenDeposit) (handled bool, err error) {
 	l1Denom := msg.Amount.Denom
@@ -56,6 +86,22 @@ func (k Keeper) HandleMigratedTokenDeposit(ctx context.Context, msg *types.MsgIn
 		return false, nil
 	} else if err != nil {
 		return false, err
+	} else if migrationInfo.MigrationPaused {
+		// the deposits are made through the OP bridge while the migration is paused, and the
+		// bridge account pays their withdrawals up to the deposited amount.
+		if err := k.updateMigrationStatus(ctx, msg.BridgeId, l1Denom, func(migrationStatus *types.MigrationStatus) {
+			migrationStatus.PausedDeposited = migrationStatus.PausedDeposited.Add(msg.Amount)
+		}); err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}
+
+	// the route memo is executed on L2 without signature, but the forwarded transfer does not
+	// carry a depositor the route can be bound to
+	if _, ok := types.ParseDepositRouteMemo(msg.Data); ok {
+		return false, types.ErrInvalidData.Wrap("deposit route is not supported for migrated tokens")
 	}
 
 	memo := "forwarded from ophost module"
@@ -93,6 +139,12 @@ func (k Keeper) HandleMigratedTokenDeposit(ctx context.Context, msg *types.MsgIn
 		sdkCtx.EventManager().EmitEvents(res.GetEvents())
 	}
 
+	if err := k.updateMigrationStatus(ctx, msg.BridgeId, l1Denom, func(migrationStatus *types.MigrationStatus) {
+		migrationStatus.Deposited = migrationStatus.Deposited.Add(msg.Amount)
+	}); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -112,20 +164,45 @@ func (k Keeper) HandleMigratedTokenWithdrawal(ctx context.Context, msg *types.Ms
 		return false, err
 	}
 
+	// the token deposited through the OP bridge while the migration is paused is held by the
+	// bridge account, so the bridge account pays the withdrawals up to the paused deposits.
+	migrationStatus, err := k.GetMigrationStatus(ctx, msg.BridgeId, l1Denom)
+	if err != nil {
+		return false, err
+	} else if msg.Amount.IsLTE(migrationStatus.PausedDeposited) {
+		migrationStatus.PausedDeposited = migrationStatus.PausedDeposited.Sub(msg.Amount)
+		if err := k.MigrationStatuses.Set(ctx, collections.Join(msg.BridgeId, l1Denom), migrationStatus); err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}
+
 	unescrowAmount := msg.Amount
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentTotalEscrow := k.transferKeeper.GetTotalEscrowForDenom(sdkCtx, migrationInfo.L1Denom)
+	escrowBalance := k.bankKeeper.GetBalance(ctx, transferEscrowAddress, migrationInfo.L1Denom)
+	if currentTotalEscrow.Amount.LT(unescrowAmount.Amount) || escrowBalance.Amount.LT(unescrowAmount.Amount) {
+		return false, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "ibc escrow balance %s is insufficient for the withdrawal %s", escrowBalance, unescrowAmount)
+	}
+
 	withdrawnFunds := sdk.NewCoins(unescrowAmount)
 	if err := k.bankKeeper.SendCoins(ctx, transferEscrowAddress, receiver, withdrawnFunds); err != nil {
 		return false, err
 	}
 
 	// decrease ibc escrow amount
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	currentTotalEscrow := k.transferKeeper.GetTotalEscrowForDenom(sdkCtx, migrationInfo.L1Denom)
 	newTotalEscrow, err := currentTotalEscrow.SafeSub(unescrowAmount)
 	if err != nil {
 		return false, err
 	}
 	k.transferKeeper.SetTotalEscrowForDenom(sdkCtx, newTotalEscrow)
 
+	if err := k.updateMigrationStatus(ctx, msg.BridgeId, l1Denom, func(migrationStatus *types.MigrationStatus) {
+		migrationStatus.Withdrawn = migrationStatus.Withdrawn.Add(unescrowAmount)
+	}); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
@@ -343,6 +343,17 @@ func Test_HandleMigratedTokenDeposit_IBCTransferSuccess(t *testing.T) {
 	_, err = ms.RegisterMigrationInfo(ctx, msg)
 	require.NoError(t, err)
 
+	// deposit route can not be forwarded to the migrated token
+	routeMsg := ophosttypes.NewMsgInitiateTokenDeposit(
+		testutil.AddrsStr[0],
+		createRes.BridgeId,
+		testutil.AddrsStr[1], // to
+		sdk.NewCoin("test1", math.NewInt(100)),
+		[]byte(`{"route":{"ibc_transfer":{"source_port":"transfer","source_channel":"channel-1","receiver":"cosmos1receiver"}}}`),
+	)
+	_, err = ms.InitiateTokenDeposit(ctx, routeMsg)
+	require.ErrorIs(t, err, ophosttypes.ErrInvalidData)
+
 	// Test migrated token deposit
 	depositMsg := ophosttypes.NewMsgInitiateTokenDeposit(
 		testutil.AddrsStr[0],
@@ -605,7 +616,212 @@ func Test_HandleMigratedTokenWithdrawal_InsufficientBalance_Direct(t *testing.T)
 		make([]byte, 32),                       // lastBlockHash
 	)
 
+	// the withdrawal is covered neither by the paused deposits nor by the ibc escrow
 	_, err = input.OPHostKeeper.HandleMigratedTokenWithdrawal(ctx, withdrawalMsg)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	escrowBalance := input.BankKeeper.GetBalance(ctx, transferEscrowAddress, "test1")
+	require.Equal(t, math.NewInt(100), escrowBalance.Amount)
+}
+
+func Test_UpdateMigrationToggles(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+
+	// Create a bridge first
+	bridgeConfig := ophosttypes.BridgeConfig{
+		Challenger:            testutil.AddrsStr[0],
+		Proposer:              testutil.AddrsStr[0],
+		SubmissionInterval:    time.Second * 10,
+		FinalizationPeriod:    time.Second * 60,
+		SubmissionStartHeight: 1,
+		Metadata:              []byte{1, 2, 3},
+		BatchInfo:             ophosttypes.BatchInfo{Submitter: testutil.AddrsStr[0], ChainType: ophosttypes.BatchInfo_INITIA},
+	}
+
+	createRes, err := ms.CreateBridge(ctx, ophosttypes.NewMsgCreateBridge(testutil.AddrsStr[0], bridgeConfig))
+	require.NoError(t, err)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// migration info not found
+	_, err = ms.UpdateMigrationToggles(ctx, ophosttypes.NewMsgUpdateMigrationToggles(authority, createRes.BridgeId, "test1", true))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	migrationInfo := ophosttypes.MigrationInfo{
+		BridgeId:     createRes.BridgeId,
+		IbcChannelId: "channel-0",
+		IbcPortId:    "transfer",
+		L1Denom:      "test1",
+	}
+	_, err = ms.RegisterMigrationInfo(ctx, ophosttypes.NewMsgRegisterMigrationInfo(authority, createRes.BridgeId, migrationInfo))
+	require.NoError(t, err)
+
+	// invalid authority
+	_, err = ms.UpdateMigrationToggles(ctx, ophosttypes.NewMsgUpdateMigrationToggles(testutil.AddrsStr[0], createRes.BridgeId, "test1", true))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// pause the migration
+	_, err = ms.UpdateMigrationToggles(ctx, ophosttypes.NewMsgUpdateMigrationToggles(authority, createRes.BridgeId, "test1", true))
+	require.NoError(t, err)
+
+	info, err := input.OPHostKeeper.GetMigrationInfo(ctx, createRes.BridgeId, "test1")
+	require.NoError(t, err)
+	require.True(t, info.MigrationPaused)
+
+	// the deposit is made through the OP bridge while the migration is paused
+	input.Faucet.Fund(ctx, testutil.Addrs[0], sdk.NewCoin("test1", math.NewInt(100)))
+	depositMsg := ophosttypes.NewMsgInitiateTokenDeposit(
+		testutil.AddrsStr[0],
+		createRes.BridgeId,
+		testutil.AddrsStr[1], // to
+		sdk.NewCoin("test1", math.NewInt(100)),
+		nil,
+	)
+
+	handled, err := input.OPHostKeeper.HandleMigratedTokenDeposit(ctx, depositMsg)
+	require.NoError(t, err)
+	require.False(t, handled)
+
+	_, err = ms.InitiateTokenDeposit(ctx, depositMsg)
+	require.NoError(t, err)
+
+	bridgeAddr := ophosttypes.BridgeAddress(createRes.BridgeId)
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, bridgeAddr, "test1").Amount)
+
+	status, err := input.OPHostKeeper.GetMigrationStatus(ctx, createRes.BridgeId, "test1")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(100)), status.PausedDeposited)
+
+	// the withdrawal over the paused deposits is not paid by the bridge account
+	_, err = input.OPHostKeeper.HandleMigratedTokenWithdrawal(ctx, ophosttypes.NewMsgFinalizeTokenWithdrawal(
+		testutil.AddrsStr[0],
+		createRes.BridgeId,
+		1,                    // outputIndex
+		1,                    // sequence
+		[][]byte{},           // withdrawalProofs
+		testutil.AddrsStr[1], // from
+		testutil.AddrsStr[0], // to
+		sdk.NewCoin("test1", math.NewInt(101)),
+		[]byte{1},        // version
+		make([]byte, 32), // storageRoot
+		make([]byte, 32), // lastBlockHash
+	))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// the withdrawal of the deposit is paid by the bridge account
+	withdrawalMsg := ophosttypes.NewMsgFinalizeTokenWithdrawal(
+		testutil.AddrsStr[0],
+		createRes.BridgeId,
+		1,                    // outputIndex
+		1,                    // sequence
+		[][]byte{},           // withdrawalProofs
+		testutil.AddrsStr[1], // from
+		testutil.AddrsStr[0], // to
+		sdk.NewCoin("test1", math.NewInt(100)),
+		[]byte{1},        // version
+		make([]byte, 32), // storageRoot
+		make([]byte, 32), // lastBlockHash
+	)
+
+	handled, err = input.OPHostKeeper.HandleMigratedTokenWithdrawal(ctx, withdrawalMsg)
+	require.NoError(t, err)
+	require.False(t, handled)
+
+	status, err = input.OPHostKeeper.GetMigrationStatus(ctx, createRes.BridgeId, "test1")
+	require.NoError(t, err)
+	require.True(t, status.PausedDeposited.IsZero())
+
+	// resume the migration
+	_, err = ms.UpdateMigrationToggles(ctx, ophosttypes.NewMsgUpdateMigrationToggles(authority, createRes.BridgeId, "test1", false))
+	require.NoError(t, err)
+
+	info, err = input.OPHostKeeper.GetMigrationInfo(ctx, createRes.BridgeId, "test1")
+	require.NoError(t, err)
+	require.False(t, info.MigrationPaused)
+}
+
+func Test_MigrationStatus(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+	querier := keeper.NewQuerier(input.OPHostKeeper)
+
+	// Create a bridge first
+	bridgeConfig := ophosttypes.BridgeConfig{
+		Challenger:            testutil.AddrsStr[0],
+		Proposer:              testutil.AddrsStr[0],
+		SubmissionInterval:    time.Second * 10,
+		FinalizationPeriod:    time.Second * 60,
+		SubmissionStartHeight: 1,
+		Metadata:              []byte{1, 2, 3},
+		BatchInfo:             ophosttypes.BatchInfo{Submitter: testutil.AddrsStr[0], ChainType: ophosttypes.BatchInfo_INITIA},
+	}
+
+	createRes, err := ms.CreateBridge(ctx, ophosttypes.NewMsgCreateBridge(testutil.AddrsStr[0], bridgeConfig))
+	require.NoError(t, err)
+
+	// Fund the bridge account
+	bridgeAddr := ophosttypes.BridgeAddress(createRes.BridgeId)
+	input.Faucet.Fund(ctx, bridgeAddr, sdk.NewCoin("test1", math.NewInt(1000)))
+
+	// migration info not found
+	_, err = querier.MigrationStatus(ctx, &ophosttypes.QueryMigrationStatusRequest{BridgeId: createRes.BridgeId, L1Denom: "test1"})
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "insufficient funds")
+
+	migrationInfo := ophosttypes.MigrationInfo{
+		BridgeId:     createRes.BridgeId,
+		IbcChannelId: "channel-0",
+		IbcPortId:    "transfer",
+		L1Denom:      "test1",
+	}
+	_, err = ms.RegisterMigrationInfo(ctx, ophosttypes.NewMsgRegisterMigrationInfo(authtypes.NewModuleAddress(govtypes.ModuleName).String(), createRes.BridgeId, migrationInfo))
+	require.NoError(t, err)
+
+	// the bridge escrow is moved to the ibc escrow
+	res, err := querier.MigrationStatus(ctx, &ophosttypes.QueryMigrationStatusRequest{BridgeId: createRes.BridgeId, L1Denom: "test1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(1000)), res.MigrationStatus.RegisteredEscrow)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(1000)), res.Migrated)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(0)), res.Remaining)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(1000)), res.Escrowed)
+
+	// forward a deposit to the ibc channel
+	handled, err := input.OPHostKeeper.HandleMigratedTokenDeposit(ctx, ophosttypes.NewMsgInitiateTokenDeposit(
+		testutil.AddrsStr[0],
+		createRes.BridgeId,
+		testutil.AddrsStr[1], // to
+		sdk.NewCoin("test1", math.NewInt(200)),
+		nil,
+	))
+	require.NoError(t, err)
+	require.True(t, handled)
+
+	// withdraw from the ibc escrow
+	handled, err = input.OPHostKeeper.HandleMigratedTokenWithdrawal(ctx, ophosttypes.NewMsgFinalizeTokenWithdrawal(
+		testutil.AddrsStr[0],
+		createRes.BridgeId,
+		1,                    // outputIndex
+		1,                    // sequence
+		[][]byte{},           // withdrawalProofs
+		testutil.AddrsStr[0], // from
+		testutil.AddrsStr[1], // to
+		sdk.NewCoin("test1", math.NewInt(300)),
+		[]byte{1},        // version
+		make([]byte, 32), // storageRoot
+		make([]byte, 32), // lastBlockHash
+	))
+	require.NoError(t, err)
+	require.True(t, handled)
+
+	res, err = querier.MigrationStatus(ctx, &ophosttypes.QueryMigrationStatusRequest{BridgeId: createRes.BridgeId, L1Denom: "test1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(200)), res.MigrationStatus.Deposited)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(300)), res.MigrationStatus.Withdrawn)
+	require.Equal(t, sdk.NewCoin("test1", math.NewInt(900)), res.Migrated)
+
+	// the counters are exported to the genesis
+	genState := input.OPHostKeeper.ExportGenesis(ctx)
+	require.Equal(t, []ophosttypes.MigrationStatus{res.MigrationStatus}, genState.MigrationStatuses)
 }
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"strconv"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,6 +16,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	"github.com/initia-labs/OPinit/x/ophost/types"
+	"github.com/initia-labs/OPinit/x/ophost/types/hook"
 )
 
 type MsgServer struct {
@@ -150,16 +153,26 @@ func (ms MsgServer) ProposeOutput(ctx context.Context, req *types.MsgProposeOutp
 		}
 	}
 
+	// no output is accepted while a forced tx is overdue
+	if err := ms.checkForcedInclusion(ctx, bridgeId, bridgeConfig); err != nil {
+		return nil, err
+	}
+
 	// store output proposal
-	if err := ms.SetOutputProposal(ctx, bridgeId, outputIndex, types.Output{
+	output := types.Output{
 		OutputRoot:    outputRoot,
 		L1BlockNumber: uint64(sdkCtx.BlockHeight()), //nolint:gosec
 		L1BlockTime:   sdkCtx.BlockTime(),
 		L2BlockNumber: l2BlockNumber,
-	}); err != nil {
+	}
+	if err := ms.SetOutputProposal(ctx, bridgeId, outputIndex, output); err != nil {
 		return nil, err
 	}
 
+	ms.runBridgeHook(ctx, bridgeId, "output_proposed", func(ctx context.Context) error {
+		return ms.bridgeHook.OutputProposed(ctx, bridgeId, outputIndex, output)
+	})
+
 	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeProposeOutput,
 		sdk.NewAttribute(types.AttributeKeyProposer, proposer),
@@ -212,6 +225,10 @@ func (ms MsgServer) DeleteOutput(ctx context.Context, req *types.MsgDeleteOutput
 		return nil, err
 	}
 
+	ms.runBridgeHook(ctx, bridgeId, "output_deleted", func(ctx context.Context) error {
+		return ms.bridgeHook.OutputDeleted(ctx, bridgeId, outputIndex)
+	})
+
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeDeleteOutput,
 		sdk.NewAttribute(types.AttributeKeyChallenger, challenger),
@@ -275,6 +292,17 @@ func (ms MsgServer) InitiateTokenDeposit(ctx context.Context, req *types.MsgInit
 		}
 	}
 
+	// record the deposit commitment to allow the deposit to be proven on L2
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	commitment := types.GenerateDepositCommitment(bridgeId, l1Sequence, height, req.Sender, req.To, coin.Denom, coin.Amount, req.Data)
+	if err := ms.SetDepositCommitment(ctx, bridgeId, l1Sequence, commitment[:]); err != nil {
+		return nil, err
+	}
+
+	ms.runBridgeHook(ctx, bridgeId, "token_deposited", func(ctx context.Context) error {
+		return ms.bridgeHook.TokenDeposited(ctx, bridgeId, l1Sequence, req.Sender, req.To, coin, req.Data)
+	})
+
 	// emit events for bridge executor
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeInitiateTokenDeposit,
@@ -293,6 +321,21 @@ func (ms MsgServer) InitiateTokenDeposit(ctx context.Context, req *types.MsgInit
 	}, nil
 }
 
+func (ms MsgServer) SubmitForcedTx(ctx context.Context, req *types.MsgSubmitForcedTx) (*types.MsgSubmitForcedTxResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	sequence, err := ms.Keeper.SubmitForcedTx(ctx, req.BridgeId, req.Sender, req.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitForcedTxResponse{
+		Sequence: sequence,
+	}, nil
+}
+
 func (ms MsgServer) FinalizeTokenWithdrawal(ctx context.Context, req *types.MsgFinalizeTokenWithdrawal) (*types.MsgFinalizeTokenWithdrawalResponse, error) {
 	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
 		return nil, err
@@ -360,6 +403,10 @@ func (ms MsgServer) FinalizeTokenWithdrawal(ctx context.Context, req *types.MsgF
 		}
 	}
 
+	ms.runBridgeHook(ctx, bridgeId, "withdrawal_finalized", func(ctx context.Context) error {
+		return ms.bridgeHook.WithdrawalFinalized(ctx, bridgeId, outputIndex, l2Sequence, req.From, req.To, req.Amount)
+	})
+
 	// emit events regardless of the token is migrated or not
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeFinalizeTokenWithdrawal,
@@ -533,6 +580,7 @@ func (ms MsgServer) UpdateOracleConfig(ctx context.Context, req *types.MsgUpdate
 	}
 
 	config.OracleEnabled = req.OracleEnabled
+	config.OraclePushInterval = req.OraclePushInterval
 
 	if err := ms.SetBridgeConfig(ctx, bridgeId, config); err != nil {
 		return nil, err
@@ -542,6 +590,7 @@ func (ms MsgServer) UpdateOracleConfig(ctx context.Context, req *types.MsgUpdate
 		types.EventTypeUpdateOracle,
 		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
 		sdk.NewAttribute(types.AttributeKeyOracleEnabled, strconv.FormatBool(config.OracleEnabled)),
+		sdk.NewAttribute(types.AttributeKeyOraclePushInterval, strconv.FormatUint(config.OraclePushInterval, 10)),
 	))
 	return &types.MsgUpdateOracleConfigResponse{}, nil
 }
@@ -576,6 +625,129 @@ func (ms MsgServer) UpdateChannelId(ctx context.Context, req *types.MsgUpdateCha
 	return &types.MsgUpdateChannelIdResponse{}, nil
 }
 
+func (ms MsgServer) AddPermChannel(ctx context.Context, req *types.MsgAddPermChannel) (*types.MsgAddPermChannelResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	bridgeId := req.BridgeId
+	config, err := ms.GetBridgeConfig(ctx, bridgeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// gov or current proposer can add perm channel.
+	if ms.authority != req.Authority && config.Proposer != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s or %s, got %s", ms.authority, config.Proposer, req.Authority)
+	}
+
+	if config.HasPermChannel(req.PortId, req.ChannelId) {
+		return nil, types.ErrPermChannelAlreadyExists.Wrapf("%s/%s", req.PortId, req.ChannelId)
+	}
+
+	config.PermChannels = append(config.PermChannels, types.PermChannel{
+		PortId:    req.PortId,
+		ChannelId: req.ChannelId,
+	})
+	if err := ms.bridgeHook.BridgePermChannelsUpdated(ctx, bridgeId, config); err != nil {
+		return nil, err
+	}
+
+	if err := ms.SetBridgeConfig(ctx, bridgeId, config); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddPermChannel,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPortId, req.PortId),
+		sdk.NewAttribute(types.AttributeKeyChannelId, req.ChannelId),
+		sdk.NewAttribute(types.AttributeKeyPermChannelAdmin, config.PermChannelAdminAddress()),
+	))
+
+	return &types.MsgAddPermChannelResponse{}, nil
+}
+
+// RemovePermChannel removes the channel from the perm channels of the bridge, including the
+// channels registered in the deprecated metadata, and clears the ibcperm admin of the channel.
+func (ms MsgServer) RemovePermChannel(ctx context.Context, req *types.MsgRemovePermChannel) (*types.MsgRemovePermChannelResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	bridgeId := req.BridgeId
+	config, err := ms.GetBridgeConfig(ctx, bridgeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// gov or current proposer can remove perm channel.
+	if ms.authority != req.Authority && config.Proposer != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s or %s, got %s", ms.authority, config.Proposer, req.Authority)
+	}
+
+	config, found := hook.RemovePermChannel(config, req.PortId, req.ChannelId)
+	if !found {
+		return nil, types.ErrPermChannelNotFound.Wrapf("%s/%s", req.PortId, req.ChannelId)
+	}
+
+	if err := ms.bridgeHook.BridgePermChannelRemoved(ctx, bridgeId, req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if err := ms.bridgeHook.BridgePermChannelsUpdated(ctx, bridgeId, config); err != nil {
+		return nil, err
+	}
+
+	if err := ms.SetBridgeConfig(ctx, bridgeId, config); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemovePermChannel,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPortId, req.PortId),
+		sdk.NewAttribute(types.AttributeKeyChannelId, req.ChannelId),
+	))
+
+	return &types.MsgRemovePermChannelResponse{}, nil
+}
+
+func (ms MsgServer) UpdatePermChannelAdmin(ctx context.Context, req *types.MsgUpdatePermChannelAdmin) (*types.MsgUpdatePermChannelAdminResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	bridgeId := req.BridgeId
+	config, err := ms.GetBridgeConfig(ctx, bridgeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// only gov can update perm channel admin.
+	if ms.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	config.PermChannelAdmin = req.Admin
+	if err := ms.bridgeHook.BridgePermChannelAdminUpdated(ctx, bridgeId, config); err != nil {
+		return nil, err
+	}
+
+	if err := ms.SetBridgeConfig(ctx, bridgeId, config); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdatePermChannelAdmin,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPermChannelAdminRole, config.PermChannelAdmin.Role.String()),
+		sdk.NewAttribute(types.AttributeKeyPermChannelAdmin, config.PermChannelAdminAddress()),
+	))
+
+	return &types.MsgUpdatePermChannelAdminResponse{}, nil
+}
+
 func (ms MsgServer) UpdateMetadata(ctx context.Context, req *types.MsgUpdateMetadata) (*types.MsgUpdateMetadataResponse, error) {
 	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
 		return nil, err
@@ -661,6 +833,36 @@ func (ms MsgServer) UpdateFinalizationPeriod(ctx context.Context, req *types.Msg
 	return &types.MsgUpdateFinalizationPeriodResponse{}, nil
 }
 
+// UpdateForcedInclusionPeriod implements updating the forced inclusion period
+func (ms MsgServer) UpdateForcedInclusionPeriod(ctx context.Context, req *types.MsgUpdateForcedInclusionPeriod) (*types.MsgUpdateForcedInclusionPeriodResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	bridgeId := req.BridgeId
+	config, err := ms.GetBridgeConfig(ctx, bridgeId)
+	if err != nil {
+		return nil, err
+	}
+
+	config.ForcedInclusionPeriod = req.ForcedInclusionPeriod
+	if err := ms.SetBridgeConfig(ctx, bridgeId, config); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateForcedInclusion,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyForcedInclusionPeriod, strconv.FormatUint(req.ForcedInclusionPeriod, 10)),
+	))
+
+	return &types.MsgUpdateForcedInclusionPeriodResponse{}, nil
+}
+
 func (ms MsgServer) DisableBridge(ctx context.Context, req *types.MsgDisableBridge) (*types.MsgDisableBridgeResponse, error) {
 	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
 		return nil, err
@@ -688,6 +890,10 @@ func (ms MsgServer) DisableBridge(ctx context.Context, req *types.MsgDisableBrid
 		return nil, err
 	}
 
+	ms.runBridgeHook(ctx, bridgeId, "bridge_disabled", func(ctx context.Context) error {
+		return ms.bridgeHook.BridgeDisabled(ctx, bridgeId, config)
+	})
+
 	return &types.MsgDisableBridgeResponse{}, nil
 }
 
@@ -724,6 +930,10 @@ func (ms MsgServer) RegisterAttestorSet(ctx context.Context, req *types.MsgRegis
 		return nil, errorsmod.Wrap(err, "failed to send attestor set update packet to L2")
 	}
 
+	ms.runBridgeHook(ctx, bridgeId, "attestor_set_updated", func(ctx context.Context) error {
+		return ms.bridgeHook.AttestorSetUpdated(ctx, bridgeId, config.AttestorSet)
+	})
+
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeRegisterAttestorSet,
 		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
@@ -768,6 +978,10 @@ func (ms MsgServer) AddAttestor(ctx context.Context, req *types.MsgAddAttestor)
 		return nil, errorsmod.Wrap(err, "failed to send attestor set update packet to L2")
 	}
 
+	ms.runBridgeHook(ctx, bridgeId, "attestor_set_updated", func(ctx context.Context) error {
+		return ms.bridgeHook.AttestorSetUpdated(ctx, bridgeId, config.AttestorSet)
+	})
+
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeAddAttestor,
 		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
@@ -821,6 +1035,10 @@ func (ms MsgServer) RemoveAttestor(ctx context.Context, req *types.MsgRemoveAtte
 		return nil, errorsmod.Wrap(err, "failed to send attestor set update packet to L2")
 	}
 
+	ms.runBridgeHook(ctx, bridgeId, "attestor_set_updated", func(ctx context.Context) error {
+		return ms.bridgeHook.AttestorSetUpdated(ctx, bridgeId, config.AttestorSet)
+	})
+
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeRemoveAttestor,
 		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
@@ -880,6 +1098,13 @@ func (ms MsgServer) RegisterMigrationInfo(ctx context.Context, req *types.MsgReg
 		ms.transferKeeper.SetTotalEscrowForDenom(sdkCtx, newTotalEscrow)
 	}
 
+	// start the migration counters from the moved escrow
+	migrationStatus := types.NewMigrationStatus(req.MigrationInfo.BridgeId, req.MigrationInfo.L1Denom)
+	migrationStatus.RegisteredEscrow = sdk.NewCoin(req.MigrationInfo.L1Denom, escrowToken.Amount)
+	if err := ms.MigrationStatuses.Set(ctx, collections.Join(req.MigrationInfo.BridgeId, req.MigrationInfo.L1Denom), migrationStatus); err != nil {
+		return nil, err
+	}
+
 	// emit event
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeRegisterMigrationInfo,
@@ -891,3 +1116,62 @@ func (ms MsgServer) RegisterMigrationInfo(ctx context.Context, req *types.MsgReg
 
 	return &types.MsgRegisterMigrationInfoResponse{}, nil
 }
+
+// UpdateMigrationToggles implements updating the migration toggles of the l1 denom
+func (ms MsgServer) UpdateMigrationToggles(ctx context.Context, req *types.MsgUpdateMigrationToggles) (*types.MsgUpdateMigrationTogglesResponse, error) {
+	// validate the message
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	// check if the authority is valid
+	if ms.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	migrationInfo, err := ms.GetMigrationInfo(ctx, req.BridgeId, req.L1Denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.ErrNotFound.Wrap("migration info not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	migrationInfo.MigrationPaused = req.MigrationPaused
+	if err := ms.SetMigrationInfo(ctx, migrationInfo); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateMigrationToggles,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(req.BridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyL1Denom, req.L1Denom),
+		sdk.NewAttribute(types.AttributeKeyMigrationPaused, strconv.FormatBool(req.MigrationPaused)),
+	))
+
+	return &types.MsgUpdateMigrationTogglesResponse{}, nil
+}
+
+// runBridgeHook runs a bridge hook in a cache context, so a failing subscriber can not revert
+// the deposit or the withdrawal of the users, nor the output and the bridge lifecycle actions
+// such as the output deletion of the challenger. The failure is logged and emitted.
+func (ms MsgServer) runBridgeHook(ctx context.Context, bridgeId uint64, hookName string, hook func(ctx context.Context) error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		ms.Logger(ctx).Error("failed to run bridge hook",
+			"bridge_id", bridgeId,
+			"hook", hookName,
+			"error", err.Error(),
+		)
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBridgeHookFailed,
+			sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+			sdk.NewAttribute(types.AttributeKeyHook, hookName),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return
+	}
+
+	writeCache()
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"encoding/base64"
+	"errors"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/initia-labs/OPinit/x/ophost/keeper"
 	"github.com/initia-labs/OPinit/x/ophost/testutil"
 	"github.com/initia-labs/OPinit/x/ophost/types"
+	"github.com/initia-labs/OPinit/x/ophost/types/hook"
 )
 
 func Test_RecordBatch(t *testing.T) {
@@ -95,6 +97,7 @@ func Test_ProposeOutput(t *testing.T) {
 		L1BlockTime:   blockTime,
 		L2BlockNumber: 100,
 	}, output)
+	require.Equal(t, uint64(1), input.BridgeHook.ProposedOutputIndex)
 }
 
 func Test_DeleteOutput(t *testing.T) {
@@ -131,6 +134,7 @@ func Test_DeleteOutput(t *testing.T) {
 	// valid by challenger
 	_, err = ms.DeleteOutput(ctx, types.NewMsgDeleteOutput(testutil.AddrsStr[1], 1, 1))
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), input.BridgeHook.DeletedOutputIndex)
 
 	// should return error; deleted
 	_, err = input.OPHostKeeper.GetOutputProposal(ctx, 1, 2)
@@ -158,6 +162,17 @@ func Test_DeleteOutput(t *testing.T) {
 	// valid delete by proposer
 	_, err = ms.DeleteOutput(ctx, types.NewMsgDeleteOutput(testutil.AddrsStr[0], 1, 1))
 	require.NoError(t, err)
+
+	// failing hook does not revert the output proposal and the deletion of the challenger
+	input.BridgeHook.Err = errors.New("hook failed")
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(testutil.AddrsStr[0], 1, 1, 100, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+	require.NoError(t, err)
+	_, err = ms.DeleteOutput(ctx, types.NewMsgDeleteOutput(testutil.AddrsStr[1], 1, 1))
+	require.NoError(t, err)
+	input.BridgeHook.Err = nil
+
+	_, err = input.OPHostKeeper.GetOutputProposal(ctx, 1, 1)
+	require.Error(t, err)
 }
 
 func Test_InitiateTokenDeposit(t *testing.T) {
@@ -186,6 +201,34 @@ func Test_InitiateTokenDeposit(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, input.BankKeeper.GetBalance(ctx, testutil.Addrs[1], sdk.DefaultBondDenom).IsZero())
 	require.Equal(t, amount, input.BankKeeper.GetBalance(ctx, types.BridgeAddress(1), sdk.DefaultBondDenom))
+	require.Equal(t, []uint64{1}, input.BridgeHook.DepositSequences)
+
+	// deposit commitment is recorded
+	commitment, err := input.OPHostKeeper.GetDepositCommitment(ctx, 1, 1)
+	require.NoError(t, err)
+	expectedCommitment := types.GenerateDepositCommitment(1, 1, uint64(ctx.BlockHeight()), testutil.AddrsStr[1], "l2_addr", sdk.DefaultBondDenom, amount.Amount, []byte("messages"))
+	require.Equal(t, expectedCommitment[:], commitment)
+
+	// failing hook does not revert the deposit
+	input.BridgeHook.Err = errors.New("hook failed")
+	input.Faucet.Fund(ctx, testutil.Addrs[1], amount)
+	_, err = ms.InitiateTokenDeposit(
+		ctx,
+		types.NewMsgInitiateTokenDeposit(testutil.AddrsStr[1], 1, "l2_addr", amount, []byte("messages")),
+	)
+	require.NoError(t, err)
+	require.Equal(t, amount.Add(amount), input.BankKeeper.GetBalance(ctx, types.BridgeAddress(1), sdk.DefaultBondDenom))
+	require.Equal(t, []uint64{1}, input.BridgeHook.DepositSequences)
+	input.BridgeHook.Err = nil
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBridgeHookFailed {
+			found = true
+			break
+		}
+	}
+	require.True(t, found)
 
 	// not existing bridge
 	_, err = ms.InitiateTokenDeposit(
@@ -265,6 +308,7 @@ func Test_FinalizeTokenWithdrawal(t *testing.T) {
 	receiverAddr, err := sdk.AccAddressFromBech32(receiver)
 	require.NoError(t, err)
 	require.Equal(t, amount, input.BankKeeper.GetBalance(ctx, receiverAddr, amount.Denom))
+	require.Equal(t, []uint64{1}, input.BridgeHook.WithdrawalSequences)
 }
 
 func Test_FinalizeTokenWithdrawal_MigratedToken(t *testing.T) {
@@ -548,7 +592,7 @@ func Test_UpdateOracleConfig(t *testing.T) {
 	// gov signer
 	govAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
 	require.NoError(t, err)
-	msg := types.NewMsgUpdateOracleConfig(govAddr, 1, false)
+	msg := types.NewMsgUpdateOracleConfig(govAddr, 1, false, 0)
 	_, err = ms.UpdateOracleConfig(ctx, msg)
 	require.NoError(t, err)
 	_config, err := ms.GetBridgeConfig(ctx, 1)
@@ -556,17 +600,18 @@ func Test_UpdateOracleConfig(t *testing.T) {
 	require.Equal(t, false, _config.OracleEnabled)
 
 	// current proposer signer
-	msg = types.NewMsgUpdateOracleConfig(testutil.AddrsStr[0], 1, true)
+	msg = types.NewMsgUpdateOracleConfig(testutil.AddrsStr[0], 1, true, 10)
 	_, err = ms.UpdateOracleConfig(ctx, msg)
 	require.NoError(t, err)
 	_config, err = ms.GetBridgeConfig(ctx, 1)
 	require.NoError(t, err)
 	require.Equal(t, true, _config.OracleEnabled)
+	require.Equal(t, uint64(10), _config.OraclePushInterval)
 
 	// invalid signer
 	invalidAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
 	require.NoError(t, err)
-	msg = types.NewMsgUpdateOracleConfig(invalidAddr, 1, false)
+	msg = types.NewMsgUpdateOracleConfig(invalidAddr, 1, false, 0)
 	require.NoError(t, err)
 
 	_, err = ms.UpdateOracleConfig(ctx, msg)
@@ -623,6 +668,114 @@ func Test_UpdateMetadata(t *testing.T) {
 
 }
 
+func Test_MsgServer_PermChannels(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+
+	config := types.BridgeConfig{
+		Proposer:              testutil.AddrsStr[0],
+		Challenger:            testutil.AddrsStr[1],
+		SubmissionInterval:    time.Second * 10,
+		FinalizationPeriod:    time.Second * 60,
+		SubmissionStartHeight: 1,
+		BatchInfo:             types.BatchInfo{Submitter: testutil.AddrsStr[0], ChainType: types.BatchInfo_INITIA},
+		PermChannels:          []types.PermChannel{{PortId: "transfer", ChannelId: "channel-0"}},
+	}
+
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(testutil.AddrsStr[0], config))
+	require.NoError(t, err)
+
+	govAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	// current proposer can add perm channel
+	_, err = ms.AddPermChannel(ctx, types.NewMsgAddPermChannel(testutil.AddrsStr[0], 1, "transfer", "channel-1"))
+	require.NoError(t, err)
+	require.Equal(t, []types.PermChannel{
+		{PortId: "transfer", ChannelId: "channel-0"},
+		{PortId: "transfer", ChannelId: "channel-1"},
+	}, input.BridgeHook.PermChannels)
+
+	// duplicated perm channel
+	_, err = ms.AddPermChannel(ctx, types.NewMsgAddPermChannel(govAddr, 1, "transfer", "channel-1"))
+	require.ErrorIs(t, err, types.ErrPermChannelAlreadyExists)
+
+	// invalid signer
+	_, err = ms.AddPermChannel(ctx, types.NewMsgAddPermChannel(testutil.AddrsStr[1], 1, "transfer", "channel-2"))
+	require.Error(t, err)
+
+	// gov can remove perm channel
+	_, err = ms.RemovePermChannel(ctx, types.NewMsgRemovePermChannel(govAddr, 1, "transfer", "channel-0"))
+	require.NoError(t, err)
+	_config, err := ms.GetBridgeConfig(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []types.PermChannel{{PortId: "transfer", ChannelId: "channel-1"}}, _config.PermChannels)
+
+	// not found
+	_, err = ms.RemovePermChannel(ctx, types.NewMsgRemovePermChannel(govAddr, 1, "transfer", "channel-0"))
+	require.ErrorIs(t, err, types.ErrPermChannelNotFound)
+
+	// only gov can update perm channel admin
+	admin := types.PermChannelAdmin{Role: types.PermChannelAdmin_ACCOUNT, Address: testutil.AddrsStr[2]}
+	_, err = ms.UpdatePermChannelAdmin(ctx, types.NewMsgUpdatePermChannelAdmin(testutil.AddrsStr[0], 1, admin))
+	require.Error(t, err)
+
+	_, err = ms.UpdatePermChannelAdmin(ctx, types.NewMsgUpdatePermChannelAdmin(govAddr, 1, admin))
+	require.NoError(t, err)
+	require.Equal(t, admin, input.BridgeHook.PermChannelAdmin)
+
+	_config, err = ms.GetBridgeConfig(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, testutil.AddrsStr[2], _config.PermChannelAdminAddress())
+
+	// account role requires an address
+	_, err = ms.UpdatePermChannelAdmin(ctx, types.NewMsgUpdatePermChannelAdmin(govAddr, 1, types.PermChannelAdmin{Role: types.PermChannelAdmin_ACCOUNT}))
+	require.ErrorIs(t, err, types.ErrInvalidPermChannelAdmin)
+}
+
+func Test_MsgServer_RemovePermChannel_Metadata(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+
+	config := types.BridgeConfig{
+		Proposer:              testutil.AddrsStr[0],
+		Challenger:            testutil.AddrsStr[1],
+		SubmissionInterval:    time.Second * 10,
+		FinalizationPeriod:    time.Second * 60,
+		SubmissionStartHeight: 1,
+		BatchInfo:             types.BatchInfo{Submitter: testutil.AddrsStr[0], ChainType: types.BatchInfo_INITIA},
+		Metadata:              []byte(`{"perm_channels":[{"port_id":"transfer","channel_id":"channel-0"},{"port_id":"transfer","channel_id":"channel-2"}]}`),
+		PermChannels:          []types.PermChannel{{PortId: "transfer", ChannelId: "channel-0"}, {PortId: "transfer", ChannelId: "channel-1"}},
+	}
+
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(testutil.AddrsStr[0], config))
+	require.NoError(t, err)
+
+	// channel-0 is registered in both the perm channels and the metadata
+	_, err = ms.RemovePermChannel(ctx, types.NewMsgRemovePermChannel(testutil.AddrsStr[0], 1, "transfer", "channel-0"))
+	require.NoError(t, err)
+	require.Equal(t, []types.PermChannel{{PortId: "transfer", ChannelId: "channel-0"}}, input.BridgeHook.RemovedPermChannels)
+
+	_config, err := ms.GetBridgeConfig(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []types.PermChannel{{PortId: "transfer", ChannelId: "channel-1"}}, _config.PermChannels)
+	require.Equal(t, []types.PermChannel{
+		{PortId: "transfer", ChannelId: "channel-1"},
+		{PortId: "transfer", ChannelId: "channel-2"},
+	}, hook.PermChannels(_config))
+
+	// channel-2 is registered only in the metadata
+	_, err = ms.RemovePermChannel(ctx, types.NewMsgRemovePermChannel(testutil.AddrsStr[0], 1, "transfer", "channel-2"))
+	require.NoError(t, err)
+
+	_config, err = ms.GetBridgeConfig(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []types.PermChannel{{PortId: "transfer", ChannelId: "channel-1"}}, hook.PermChannels(_config))
+
+	_, err = ms.RemovePermChannel(ctx, types.NewMsgRemovePermChannel(testutil.AddrsStr[0], 1, "transfer", "channel-0"))
+	require.ErrorIs(t, err, types.ErrPermChannelNotFound)
+}
+
 func Test_MsgServer_DisableBridge(t *testing.T) {
 	ctx, input := testutil.CreateTestInput(t, false)
 	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
@@ -651,6 +804,19 @@ func Test_MsgServer_DisableBridge(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, true, _config.BridgeDisabled)
 	require.Equal(t, ctx.BlockTime(), _config.BridgeDisabledAt)
+	require.True(t, input.BridgeHook.Disabled)
+
+	// failing hook does not revert disabling the bridge
+	_, err = ms.CreateBridge(ctx, types.NewMsgCreateBridge(testutil.AddrsStr[0], config))
+	require.NoError(t, err)
+	input.BridgeHook.Err = errors.New("hook failed")
+	_, err = ms.DisableBridge(ctx, types.NewMsgDisableBridge(govAddr, 2))
+	require.NoError(t, err)
+	input.BridgeHook.Err = nil
+
+	_config, err = ms.GetBridgeConfig(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, _config.BridgeDisabled)
 
 	// already disabled
 	msg = types.NewMsgDisableBridge(govAddr, 1)
@@ -1101,6 +1267,7 @@ func Test_MsgServer_RegisterAttestorSet_WithChannelId(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, config.AttestorSet, 1)
 	require.Equal(t, testutil.ValAddrsStr[0], config.AttestorSet[0].OperatorAddress)
+	require.Equal(t, config.AttestorSet, input.BridgeHook.AttestorSet)
 }
 
 func Test_MsgServer_AddAttestor_EmptyChannelId(t *testing.T) {
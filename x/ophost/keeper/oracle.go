@@ -2,9 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	"github.com/initia-labs/OPinit/x/ophost/types"
 )
@@ -39,6 +44,16 @@ func (k Keeper) UpdateOraclePriceHashes(ctx context.Context) error {
 
 // computeOraclePricesHash computes a deterministic hash of all current oracle prices.
 func (k Keeper) computeOraclePricesHash(ctx sdk.Context) ([]byte, error) {
+	prices, err := k.collectOraclePrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return prices.ComputeOraclePricesHash(), nil
+}
+
+// collectOraclePrices returns all current oracle prices of the L1 oracle module.
+func (k Keeper) collectOraclePrices(ctx sdk.Context) (types.OraclePriceInfos, error) {
 	numPairs, err := k.oracleKeeper.GetNumCurrencyPairs(ctx)
 	if err != nil {
 		return nil, err
@@ -71,10 +86,233 @@ func (k Keeper) computeOraclePricesHash(ctx sdk.Context) ([]byte, error) {
 		})
 	}
 
-	return prices.ComputeOraclePricesHash(), nil
+	return prices, nil
 }
 
 // GetOraclePriceHash returns the oracle price hash for a bridge.
 func (k Keeper) GetOraclePriceHash(ctx context.Context) (types.OraclePriceHash, error) {
 	return k.OraclePriceHash.Get(ctx)
 }
+
+// isOraclePushEnabled returns true if the bridge pushes the oracle prices to L2.
+func isOraclePushEnabled(config types.BridgeConfig) bool {
+	return config.OracleEnabled && config.OraclePushInterval != 0 && !config.BridgeDisabled && config.ChannelId != ""
+}
+
+// scheduleOraclePush indexes the next oracle price push of the bridge by the L1 height, so
+// PushOraclePrices only visits the bridges due for a push. The push is unscheduled when the
+// bridge does not push the oracle prices.
+func (k Keeper) scheduleOraclePush(ctx context.Context, bridgeId uint64, config types.BridgeConfig, nextHeight uint64) error {
+	prevHeight, err := k.NextOraclePushes.Get(ctx, bridgeId)
+	if err == nil {
+		if err := k.OraclePushQueue.Remove(ctx, collections.Join(prevHeight, bridgeId)); err != nil {
+			return err
+		}
+		if err := k.NextOraclePushes.Remove(ctx, bridgeId); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if !isOraclePushEnabled(config) {
+		return nil
+	}
+
+	if err := k.NextOraclePushes.Set(ctx, bridgeId, nextHeight); err != nil {
+		return err
+	}
+
+	return k.OraclePushQueue.Set(ctx, collections.Join(nextHeight, bridgeId))
+}
+
+// rescheduleOraclePush schedules the next oracle price push of the bridge at one push interval
+// after the last push, or at the current height if the bridge has not pushed yet.
+func (k Keeper) rescheduleOraclePush(ctx context.Context, bridgeId uint64, config types.BridgeConfig) error {
+	nextHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	lastHeight, err := k.OraclePushHeights.Get(ctx, bridgeId)
+	if err == nil {
+		nextHeight = max(nextHeight, lastHeight+config.OraclePushInterval)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	return k.scheduleOraclePush(ctx, bridgeId, config, nextHeight)
+}
+
+// PushOraclePrices sends the current oracle prices to every bridge which enabled push-based
+// oracle delivery, once per the bridge's oracle push interval. This should be called in EndBlocker.
+// Only the bridges due for a push are visited, and a failure to push to one bridge is logged and
+// does not affect the others.
+func (k Keeper) PushOraclePrices(ctx context.Context) error {
+	if k.oracleKeeper == nil {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight()) //nolint:gosec
+
+	// collect the bridges due for an oracle price push
+	dueBridgeIds := []uint64{}
+	if err := k.OraclePushQueue.Walk(ctx, collections.NewPrefixUntilPairRange[uint64, uint64](height), func(key collections.Pair[uint64, uint64]) (stop bool, err error) {
+		dueBridgeIds = append(dueBridgeIds, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	if len(dueBridgeIds) == 0 {
+		return nil
+	}
+
+	prices, err := k.collectOraclePrices(sdkCtx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to collect oracle prices")
+	}
+
+	for _, bridgeId := range dueBridgeIds {
+		config, err := k.GetBridgeConfig(ctx, bridgeId)
+		if err != nil {
+			return err
+		}
+
+		// the push is retried after the interval when there are no prices or no channel yet
+		if len(prices) > 0 && k.channelKeeper.HasChannel(sdkCtx, types.PortID, config.ChannelId) {
+			// use cache context to discard partial state changes on failure
+			cacheCtx, writeCache := sdkCtx.CacheContext()
+			if err := k.SendOraclePriceUpdatePacket(cacheCtx, bridgeId, types.PortID, config.ChannelId, prices); err != nil {
+				k.Logger(ctx).Error("failed to push oracle prices",
+					"bridge_id", bridgeId,
+					"channel_id", config.ChannelId,
+					"error", err.Error(),
+				)
+			} else {
+				writeCache()
+			}
+		}
+
+		if err := k.OraclePushHeights.Set(ctx, bridgeId, height); err != nil {
+			return err
+		}
+
+		if err := k.scheduleOraclePush(ctx, bridgeId, config, height+config.OraclePushInterval); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendOraclePriceUpdatePacket sends an oracle price update packet to L2 via IBC.
+func (k Keeper) SendOraclePriceUpdatePacket(
+	ctx context.Context,
+	bridgeId uint64,
+	sourcePort, sourceChannel string,
+	prices types.OraclePriceInfos,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	packetData := types.NewOraclePriceUpdatePacket(types.NewOraclePriceUpdatePacketData(
+		bridgeId,
+		prices,
+		uint64(sdkCtx.BlockHeight()), //nolint:gosec
+		sdkCtx.BlockTime().UnixNano(),
+	))
+
+	timeoutTimestamp := uint64(sdkCtx.BlockTime().Add(types.DefaultPacketTimeoutTimestamp).UnixNano()) //nolint:gosec
+
+	sequence, err := k.channelKeeper.SendPacket(
+		sdkCtx,
+		sourcePort,
+		sourceChannel,
+		types.DefaultTransferPacketTimeoutHeight,
+		timeoutTimestamp,
+		packetData.GetBytes(),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to send IBC packet")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOraclePricePacketSent,
+			sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+			sdk.NewAttribute(types.AttributeKeyL1BlockHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+			sdk.NewAttribute(types.AttributeKeyNumCurrencyPair, strconv.Itoa(len(prices))),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, sourceChannel),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return nil
+}
+
+// OnAcknowledgeOraclePriceUpdatePacket handles the acknowledgement of an oracle price update packet.
+// The acknowledgement is only reported through events; the next push carries newer prices anyway.
+func (k Keeper) OnAcknowledgeOraclePriceUpdatePacket(
+	ctx context.Context,
+	data types.OraclePriceUpdatePacketData,
+	ack channeltypes.Acknowledgement,
+) error {
+	success := false
+	var ackErr string
+	switch resp := ack.GetResponse().(type) {
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.OraclePriceUpdatePacketAck
+		if err := json.Unmarshal(resp.Result, &packetAck); err != nil {
+			return errorsmod.Wrap(err, "failed to unmarshal oracle price update packet ack")
+		}
+
+		success = packetAck.Success
+		ackErr = packetAck.Error
+	case *channeltypes.Acknowledgement_Error:
+		ackErr = resp.Error
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(data.BridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyL1BlockHeight, strconv.FormatUint(data.L1BlockHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(success)),
+	}
+	if !success {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyAckError, ackErr))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(types.EventTypeOraclePricePacketAck, attrs...))
+
+	return nil
+}
+
+// OnTimeoutOraclePriceUpdatePacket handles the timeout of an oracle price update packet.
+// When the timed out packet is the latest push, it clears the last push height of the bridge,
+// so the prices are pushed again at the end of the block. The timeout of an older push is only
+// reported, since a newer push already carries newer prices.
+func (k Keeper) OnTimeoutOraclePriceUpdatePacket(
+	ctx context.Context,
+	data types.OraclePriceUpdatePacketData,
+) error {
+	lastHeight, err := k.OraclePushHeights.Get(ctx, data.BridgeId)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	} else if err == nil && lastHeight == data.L1BlockHeight {
+		if err := k.OraclePushHeights.Remove(ctx, data.BridgeId); err != nil {
+			return err
+		}
+
+		config, err := k.GetBridgeConfig(ctx, data.BridgeId)
+		if err == nil {
+			err = k.rescheduleOraclePush(ctx, data.BridgeId, config)
+		}
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOraclePriceTimeout,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(data.BridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyL1BlockHeight, strconv.FormatUint(data.L1BlockHeight, 10)),
+	))
+
+	return nil
+}
@@ -4,9 +4,13 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
 	"github.com/initia-labs/OPinit/x/ophost/testutil"
 	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
@@ -102,3 +106,127 @@ func Test_OraclePriceInfos_ComputeHash_OrderIndependent(t *testing.T) {
 
 	require.Equal(t, hash1, hash2, "Hash computation should be order-independent")
 }
+
+func Test_PushOraclePrices(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(100)
+
+	cp, err := connecttypes.CurrencyPairFromString("BTC/USD")
+	require.NoError(t, err)
+	input.OracleKeeper.SetPrice(cp, oracletypes.QuotePrice{
+		Price:          math.NewInt(10000000),
+		BlockTimestamp: time.Unix(0, 1000000000),
+	})
+
+	config := ophosttypes.BridgeConfig{
+		Proposer:           testutil.AddrsStr[0],
+		Challenger:         testutil.AddrsStr[1],
+		OracleEnabled:      true,
+		OraclePushInterval: 10,
+		ChannelId:          "channel-0",
+	}
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, config))
+
+	// push disabled
+	config.OraclePushInterval = 0
+	config.ChannelId = "channel-1"
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 2, config))
+
+	err = input.OPHostKeeper.PushOraclePrices(ctx)
+	require.NoError(t, err)
+	require.Len(t, input.ChannelKeeper.SentPackets, 1)
+
+	sent := input.ChannelKeeper.SentPackets[0]
+	require.Equal(t, ophosttypes.PortID, sent.SourcePort)
+	require.Equal(t, "channel-0", sent.SourceChannel)
+
+	packetData, err := ophosttypes.DecodeOPinitPacketData(sent.Data)
+	require.NoError(t, err)
+	require.Equal(t, ophosttypes.OraclePriceUpdatePacketData{
+		BridgeId: 1,
+		Prices: []ophosttypes.OraclePrice{
+			{CurrencyPairId: 0, CurrencyPair: "BTC/USD", Price: "10000000", Timestamp: 1000000000},
+		},
+		L1BlockHeight: 100,
+		L1BlockTime:   ctx.BlockTime().UnixNano(),
+	}, *packetData.OraclePriceUpdate)
+
+	pushHeight, err := input.OPHostKeeper.OraclePushHeights.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), pushHeight)
+
+	// not due yet
+	err = input.OPHostKeeper.PushOraclePrices(ctx.WithBlockHeight(109))
+	require.NoError(t, err)
+	require.Len(t, input.ChannelKeeper.SentPackets, 1)
+
+	// due again after the interval
+	err = input.OPHostKeeper.PushOraclePrices(ctx.WithBlockHeight(110))
+	require.NoError(t, err)
+	require.Len(t, input.ChannelKeeper.SentPackets, 2)
+
+	// the timeout of an older push keeps the push height
+	err = input.OPHostKeeper.OnTimeoutOraclePriceUpdatePacket(ctx, *packetData.OraclePriceUpdate)
+	require.NoError(t, err)
+
+	pushHeight, err = input.OPHostKeeper.OraclePushHeights.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(110), pushHeight)
+
+	err = input.OPHostKeeper.PushOraclePrices(ctx.WithBlockHeight(111))
+	require.NoError(t, err)
+	require.Len(t, input.ChannelKeeper.SentPackets, 2)
+
+	// the timeout of the latest push resets the push height, so the prices are pushed again
+	latest, err := ophosttypes.DecodeOPinitPacketData(input.ChannelKeeper.SentPackets[1].Data)
+	require.NoError(t, err)
+	require.Equal(t, uint64(110), latest.OraclePriceUpdate.L1BlockHeight)
+
+	ctx = ctx.WithBlockHeight(111)
+	err = input.OPHostKeeper.OnTimeoutOraclePriceUpdatePacket(ctx, *latest.OraclePriceUpdate)
+	require.NoError(t, err)
+
+	_, err = input.OPHostKeeper.OraclePushHeights.Get(ctx, 1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	err = input.OPHostKeeper.PushOraclePrices(ctx)
+	require.NoError(t, err)
+	require.Len(t, input.ChannelKeeper.SentPackets, 3)
+
+	// disabling the push removes the bridge from the push queue
+	config.OraclePushInterval = 0
+	config.ChannelId = "channel-0"
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, config))
+
+	has, err := input.OPHostKeeper.NextOraclePushes.Has(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	err = input.OPHostKeeper.PushOraclePrices(ctx.WithBlockHeight(200))
+	require.NoError(t, err)
+	require.Len(t, input.ChannelKeeper.SentPackets, 3)
+}
+
+func Test_PushOraclePrices_BridgeDisabled(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+
+	cp, err := connecttypes.CurrencyPairFromString("BTC/USD")
+	require.NoError(t, err)
+	input.OracleKeeper.SetPrice(cp, oracletypes.QuotePrice{
+		Price:          math.NewInt(10000000),
+		BlockTimestamp: time.Unix(0, 1000000000),
+	})
+
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, ophosttypes.BridgeConfig{
+		Proposer:           testutil.AddrsStr[0],
+		Challenger:         testutil.AddrsStr[1],
+		OracleEnabled:      true,
+		OraclePushInterval: 10,
+		ChannelId:          "channel-0",
+		BridgeDisabled:     true,
+	}))
+
+	err = input.OPHostKeeper.PushOraclePrices(ctx)
+	require.NoError(t, err)
+	require.Empty(t, input.ChannelKeeper.SentPackets)
+}
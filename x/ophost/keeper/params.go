@@ -13,6 +13,11 @@ func (k Keeper) RegistrationFee(ctx context.Context) sdk.Coins {
 	return k.GetParams(ctx).RegistrationFee
 }
 
+// ForcedTxFee returns params.ForcedTxFee
+func (k Keeper) ForcedTxFee(ctx context.Context) sdk.Coins {
+	return k.GetParams(ctx).ForcedTxFee
+}
+
 // SetParams sets the x/opchild module parameters.
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 	return k.Params.Set(ctx, params)
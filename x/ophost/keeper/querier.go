@@ -5,11 +5,13 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/initia-labs/OPinit/x/ophost/types"
+	"github.com/initia-labs/OPinit/x/ophost/types/hook"
 )
 
 type Querier struct {
@@ -197,6 +199,28 @@ func (q Querier) MigrationInfo(ctx context.Context, req *types.QueryMigrationInf
 	}, nil
 }
 
+// MigrationStatus implements the Query/MigrationStatus RPC method
+func (q Querier) MigrationStatus(ctx context.Context, req *types.QueryMigrationStatusRequest) (*types.QueryMigrationStatusResponse, error) {
+	if ok, err := q.HasMigrationInfo(ctx, req.BridgeId, req.L1Denom); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !ok {
+		return nil, status.Error(codes.NotFound, "migration info not found")
+	}
+
+	migrationStatus, err := q.GetMigrationStatus(ctx, req.BridgeId, req.L1Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryMigrationStatusResponse{
+		MigrationStatus: migrationStatus,
+		Migrated:        migrationStatus.Migrated(),
+		Remaining:       q.bankKeeper.GetBalance(ctx, types.BridgeAddress(req.BridgeId), req.L1Denom),
+		Escrowed:        q.transferKeeper.GetTotalEscrowForDenom(sdkCtx, req.L1Denom),
+	}, nil
+}
+
 // OraclePriceHash implements the Query/OraclePriceHash RPC method
 func (q Querier) OraclePriceHash(ctx context.Context, req *types.QueryOraclePriceHashRequest) (*types.QueryOraclePriceHashResponse, error) {
 	oraclePriceHash, err := q.GetOraclePriceHash(ctx)
@@ -210,3 +234,73 @@ func (q Querier) OraclePriceHash(ctx context.Context, req *types.QueryOraclePric
 		OraclePriceHash: oraclePriceHash,
 	}, nil
 }
+
+// L2Status implements the Query/L2Status RPC method
+func (q Querier) L2Status(ctx context.Context, req *types.QueryL2StatusRequest) (*types.QueryL2StatusResponse, error) {
+	l2Status, err := q.GetL2Status(ctx, req.BridgeId)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "l2 status not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryL2StatusResponse{
+		L2Status: l2Status,
+	}, nil
+}
+
+// PermChannels implements the Query/PermChannels RPC method
+func (q Querier) PermChannels(ctx context.Context, req *types.QueryPermChannelsRequest) (*types.QueryPermChannelsResponse, error) {
+	config, err := q.GetBridgeConfig(ctx, req.BridgeId)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "bridge not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// include the channels registered in the deprecated metadata, which share the same admin
+	admin := config.PermChannelAdminAddress()
+	allPermChannels := hook.PermChannels(config)
+	permChannels := make([]types.PermChannelInfo, len(allPermChannels))
+	for i, permChannel := range allPermChannels {
+		permChannels[i] = types.PermChannelInfo{
+			PortId:    permChannel.PortId,
+			ChannelId: permChannel.ChannelId,
+			Admin:     admin,
+		}
+	}
+
+	return &types.QueryPermChannelsResponse{
+		PermChannels: permChannels,
+		Admin:        config.PermChannelAdmin,
+	}, nil
+}
+
+// DepositCommitment implements the Query/DepositCommitment RPC method
+func (q Querier) DepositCommitment(ctx context.Context, req *types.QueryDepositCommitmentRequest) (*types.QueryDepositCommitmentResponse, error) {
+	commitment, err := q.GetDepositCommitment(ctx, req.BridgeId, req.Sequence)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "deposit commitment not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositCommitmentResponse{
+		Commitment: commitment,
+	}, nil
+}
+
+// ForcedTxs implements the Query/ForcedTxs RPC method
+func (q Querier) ForcedTxs(ctx context.Context, req *types.QueryForcedTxsRequest) (*types.QueryForcedTxsResponse, error) {
+	forcedTxs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.ForcedTxs, req.Pagination, func(_ collections.Pair[uint64, uint64], forcedTx types.ForcedTx) (types.ForcedTx, error) {
+		return forcedTx, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, uint64](req.BridgeId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryForcedTxsResponse{
+		ForcedTxs:  forcedTxs,
+		Pagination: pageRes,
+	}, nil
+}
@@ -334,3 +334,62 @@ func Test_QueryOraclePriceHash(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, oraclePriceHash, res.OraclePriceHash)
 }
+
+func Test_QueryL2Status(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	q := keeper.NewQuerier(input.OPHostKeeper)
+
+	// non-existent l2 status should return error
+	_, err := q.L2Status(ctx, &types.QueryL2StatusRequest{BridgeId: 1})
+	require.Error(t, err)
+
+	l2Status := types.L2Status{
+		Validators: []types.L2Validator{
+			{Moniker: "val1", OperatorAddress: testutil.ValAddrsStr[0], ConsPower: 1},
+		},
+		NextL2Sequence: 10,
+		L2BlockHeight:  100,
+		L2BlockTime:    time.Unix(1000, 0).UTC(),
+		L1BlockHeight:  50,
+	}
+	require.NoError(t, input.OPHostKeeper.L2Statuses.Set(ctx, 1, l2Status))
+
+	res, err := q.L2Status(ctx, &types.QueryL2StatusRequest{BridgeId: 1})
+	require.NoError(t, err)
+	require.Equal(t, l2Status, res.L2Status)
+}
+
+func Test_QueryPermChannels(t *testing.T) {
+	ctx, input := testutil.CreateTestInput(t, false)
+	q := keeper.NewQuerier(input.OPHostKeeper)
+
+	// non-existent bridge should return error
+	_, err := q.PermChannels(ctx, &types.QueryPermChannelsRequest{BridgeId: 1})
+	require.Error(t, err)
+
+	config := types.BridgeConfig{
+		Challenger:            testutil.AddrsStr[0],
+		Proposer:              testutil.AddrsStr[1],
+		SubmissionInterval:    time.Second * 10,
+		FinalizationPeriod:    time.Second * 60,
+		SubmissionStartHeight: 1,
+		BatchInfo:             types.BatchInfo{Submitter: testutil.AddrsStr[0], ChainType: types.BatchInfo_INITIA},
+		PermChannels: []types.PermChannel{
+			{PortId: "transfer", ChannelId: "channel-0"},
+			{PortId: "icqhost", ChannelId: "channel-1"},
+		},
+		PermChannelAdmin: types.PermChannelAdmin{Role: types.PermChannelAdmin_PROPOSER},
+		// deprecated metadata channels
+		Metadata: []byte(`{"perm_channels":[{"port_id":"transfer","channel_id":"channel-0"},{"port_id":"nft-transfer","channel_id":"channel-2"}]}`),
+	}
+	require.NoError(t, input.OPHostKeeper.SetBridgeConfig(ctx, 1, config))
+
+	res, err := q.PermChannels(ctx, &types.QueryPermChannelsRequest{BridgeId: 1})
+	require.NoError(t, err)
+	require.Equal(t, config.PermChannelAdmin, res.Admin)
+	require.Equal(t, []types.PermChannelInfo{
+		{PortId: "transfer", ChannelId: "channel-0", Admin: testutil.AddrsStr[1]},
+		{PortId: "icqhost", ChannelId: "channel-1", Admin: testutil.AddrsStr[1]},
+		{PortId: "nft-transfer", ChannelId: "channel-2", Admin: testutil.AddrsStr[1]},
+	}, res.PermChannels)
+}
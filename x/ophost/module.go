@@ -134,7 +134,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // EndBlock returns the end blocker for the ophost module.
 func (am AppModule) EndBlock(ctx context.Context) error {
-	return am.keeper.UpdateOraclePriceHashes(ctx)
+	if err := am.keeper.UpdateOraclePriceHashes(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.PushOraclePrices(ctx)
 }
 
 // IsAppModule implements the appmodule.AppModule interface.
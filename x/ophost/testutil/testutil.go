@@ -204,6 +204,8 @@ type TestKeepers struct {
 	MultiStore          storetypes.CommitMultiStore
 	MockRouter          *MockRouter
 	TransferKeeper      *MockTransferKeeper
+	ChannelKeeper       *MockChannelKeeper
+	OracleKeeper        *MockOracleKeeper
 }
 
 func CreateTestInput(t testing.TB, isCheckTx bool) (sdk.Context, TestKeepers) {
@@ -339,6 +341,8 @@ func createTestInput(
 		MultiStore:          ms,
 		MockRouter:          mockRouter,
 		TransferKeeper:      transferKeeper,
+		ChannelKeeper:       channelKeeper,
+		OracleKeeper:        oracleKeeper,
 	}
 	return ctx, keepers
 }
@@ -349,8 +353,21 @@ type BridgeHook struct {
 	BatchInfo  ophosttypes.BatchInfo
 	Metadata   []byte
 	Err        error
+
+	// lifecycle hooks
+	ProposedOutputIndex uint64
+	DeletedOutputIndex  uint64
+	DepositSequences    []uint64
+	WithdrawalSequences []uint64
+	Disabled            bool
+	AttestorSet         []ophosttypes.Attestor
+	PermChannels        []ophosttypes.PermChannel
+	PermChannelAdmin    ophosttypes.PermChannelAdmin
+	RemovedPermChannels []ophosttypes.PermChannel
 }
 
+var _ ophosttypes.ExtendedBridgeHook = &BridgeHook{}
+
 func (h *BridgeHook) BridgeCreated(
 	ctx context.Context,
 	bridgeId uint64,
@@ -422,6 +439,142 @@ func (h *BridgeHook) BridgeMetadataUpdated(
 	return nil
 }
 
+func (h *BridgeHook) OutputProposed(
+	ctx context.Context,
+	bridgeId uint64,
+	outputIndex uint64,
+	output ophosttypes.Output,
+) error {
+	if h.Err != nil {
+		return h.Err
+	}
+
+	h.ProposedOutputIndex = outputIndex
+
+	return nil
+}
+
+func (h *BridgeHook) OutputDeleted(
+	ctx context.Context,
+	bridgeId uint64,
+	outputIndex uint64,
+) error {
+	if h.Err != nil {
+		return h.Err
+	}
+
+	h.DeletedOutputIndex = outputIndex
+
+	return nil
+}
+
+func (h *BridgeHook) TokenDeposited(
+	ctx context.Context,
+	bridgeId uint64,
+	l1Sequence uint64,
+	from string,
+	to string,
+	amount sdk.Coin,
+	data []byte,
+) error {
+	if h.Err != nil {
+		return h.Err
+	}
+
+	h.DepositSequences = append(h.DepositSequences, l1Sequence)
+
+	return nil
+}
+
+func (h *BridgeHook) WithdrawalFinalized(
+	ctx context.Context,
+	bridgeId uint64,
+	outputIndex uint64,
+	l2Sequence uint64,
+	from string,
+	to string,
+	amount sdk.Coin,
+) error {
+	if h.Err != nil {
+		return h.Err
+	}
+
+	h.WithdrawalSequences = append(h.WithdrawalSequences, l2Sequence)
+
+	return nil
+}
+
+func (h *BridgeHook) BridgeDisabled(
+	ctx context.Context,
+	bridgeId uint64,
+	bridgeConfig ophosttypes.BridgeConfig,
+) error {
+	if h.Err != nil {
+		return h.Err
+	}
+
+	h.Disabled = bridgeConfig.BridgeDisabled
+
+	return nil
+}
+
+func (h *BridgeHook) AttestorSetUpdated(
+	ctx context.Context,
+	bridgeId uint64,
+	attestorSet []ophosttypes.Attestor,
+) error {
+	if h.Err != nil {
+		return h.Err
+	}
+
+	h.AttestorSet = attestorSet
+
+	return nil
+}
+
+func (h *BridgeHook) BridgePermChannelsUpdated(
+	ctx context.Context,
+	bridgeId uint64,
+	bridgeConfig ophosttypes.BridgeConfig,
+) error {
+	if h.Err != nil {
+		return h.Err
+	}
+
+	h.PermChannels = bridgeConfig.PermChannels
+
+	return nil
+}
+
+func (h *BridgeHook) BridgePermChannelAdminUpdated(
+	ctx context.Context,
+	bridgeId uint64,
+	bridgeConfig ophosttypes.BridgeConfig,
+) error {
+	if h.Err != nil {
+		return h.Err
+	}
+
+	h.PermChannelAdmin = bridgeConfig.PermChannelAdmin
+
+	return nil
+}
+
+func (h *BridgeHook) BridgePermChannelRemoved(
+	ctx context.Context,
+	bridgeId uint64,
+	portId string,
+	channelId string,
+) error {
+	if h.Err != nil {
+		return h.Err
+	}
+
+	h.RemovedPermChannels = append(h.RemovedPermChannels, ophosttypes.PermChannel{PortId: portId, ChannelId: channelId})
+
+	return nil
+}
+
 var _ ophosttypes.CommunityPoolKeeper = &MockCommunityPoolKeeper{}
 
 type MockCommunityPoolKeeper struct {
@@ -433,7 +586,17 @@ func (k *MockCommunityPoolKeeper) FundCommunityPool(ctx context.Context, amount
 	return nil
 }
 
-type MockChannelKeeper struct{}
+// SentPacket is a packet recorded by MockChannelKeeper.
+type SentPacket struct {
+	SourcePort    string
+	SourceChannel string
+	Sequence      uint64
+	Data          []byte
+}
+
+type MockChannelKeeper struct {
+	SentPackets []SentPacket
+}
 
 func (k *MockChannelKeeper) SendPacket(
 	ctx sdk.Context,
@@ -443,25 +606,58 @@ func (k *MockChannelKeeper) SendPacket(
 	timeoutTimestamp uint64,
 	data []byte,
 ) (sequence uint64, err error) {
-	return 1, nil
+	sequence = uint64(len(k.SentPackets)) + 1
+	k.SentPackets = append(k.SentPackets, SentPacket{
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		Sequence:      sequence,
+		Data:          data,
+	})
+
+	return sequence, nil
 }
 
 func (k *MockChannelKeeper) HasChannel(ctx sdk.Context, portID, channelID string) bool {
 	return true
 }
 
-type MockOracleKeeper struct{}
+type MockOracleKeeper struct {
+	CurrencyPairs []connecttypes.CurrencyPair
+	Prices        map[string]oracletypes.QuotePrice
+}
+
+// SetPrice registers the currency pair if needed and sets its price.
+func (k *MockOracleKeeper) SetPrice(cp connecttypes.CurrencyPair, price oracletypes.QuotePrice) {
+	if k.Prices == nil {
+		k.Prices = make(map[string]oracletypes.QuotePrice)
+	}
+
+	if _, found := k.Prices[cp.String()]; !found {
+		k.CurrencyPairs = append(k.CurrencyPairs, cp)
+	}
+
+	k.Prices[cp.String()] = price
+}
 
 func (k *MockOracleKeeper) GetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error) {
-	return oracletypes.QuotePrice{}, nil
+	price, found := k.Prices[cp.String()]
+	if !found {
+		return oracletypes.QuotePrice{}, oracletypes.QuotePriceNotExistError{}
+	}
+
+	return price, nil
 }
 
 func (k *MockOracleKeeper) GetCurrencyPairFromID(ctx context.Context, id uint64) (connecttypes.CurrencyPair, bool) {
-	return connecttypes.CurrencyPair{}, false
+	if id >= uint64(len(k.CurrencyPairs)) {
+		return connecttypes.CurrencyPair{}, false
+	}
+
+	return k.CurrencyPairs[id], true
 }
 
 func (k *MockOracleKeeper) GetNumCurrencyPairs(ctx context.Context) (uint64, error) {
-	return 0, nil
+	return uint64(len(k.CurrencyPairs)), nil
 }
 
 type MockRouter struct {
@@ -44,6 +44,14 @@ func (config BridgeConfig) Validate(ac address.Codec, vc address.Codec) error {
 		return err
 	}
 
+	if err := ValidatePermChannels(config.PermChannels); err != nil {
+		return err
+	}
+
+	if err := config.PermChannelAdmin.Validate(ac); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -82,9 +90,42 @@ func (config BridgeConfig) ValidateWithNoAddrValidation() error {
 		return err
 	}
 
+	if err := ValidatePermChannels(config.PermChannels); err != nil {
+		return err
+	}
+
+	if _, ok := PermChannelAdmin_Role_name[int32(config.PermChannelAdmin.Role)]; !ok {
+		return ErrInvalidPermChannelAdmin.Wrap("invalid role")
+	} else if config.PermChannelAdmin.Role == PermChannelAdmin_ACCOUNT && config.PermChannelAdmin.Address == "" {
+		return ErrInvalidPermChannelAdmin.Wrap("address must be set for the account role")
+	}
+
 	return nil
 }
 
+// PermChannelAdminAddress returns the address registered as the admin of the perm channels.
+func (config BridgeConfig) PermChannelAdminAddress() string {
+	switch config.PermChannelAdmin.Role {
+	case PermChannelAdmin_PROPOSER:
+		return config.Proposer
+	case PermChannelAdmin_ACCOUNT:
+		return config.PermChannelAdmin.Address
+	default:
+		return config.Challenger
+	}
+}
+
+// HasPermChannel returns true if the channel is in the perm channels of the bridge.
+func (config BridgeConfig) HasPermChannel(portId, channelId string) bool {
+	for _, permChannel := range config.PermChannels {
+		if permChannel.PortId == portId && permChannel.ChannelId == channelId {
+			return true
+		}
+	}
+
+	return false
+}
+
 // MarshalJSON marshals the BatchInfo_ChainType to JSON
 func (cy BatchInfo_ChainType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(cy.String())
@@ -107,6 +148,28 @@ func (cy *BatchInfo_ChainType) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON marshals the PermChannelAdmin_Role to JSON
+func (role PermChannelAdmin_Role) MarshalJSON() ([]byte, error) {
+	return json.Marshal(role.String())
+}
+
+// UnmarshalJSON unmarshals the PermChannelAdmin_Role from JSON
+func (role *PermChannelAdmin_Role) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err != nil {
+		return err
+	}
+
+	value, ok := PermChannelAdmin_Role_value[strings.ToUpper(str)]
+	if !ok {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid perm channel admin role")
+	}
+
+	*role = PermChannelAdmin_Role(value)
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (config BridgeConfig) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for i := range config.AttestorSet {
@@ -54,6 +54,53 @@ func Test_ValidateBridgeConfig(t *testing.T) {
 	// 1.2 unknown chain type
 	config.BatchInfo.ChainType = 100
 	require.Error(t, config.ValidateWithNoAddrValidation())
+	config.BatchInfo.ChainType = BatchInfo_INITIA
+
+	// 2. perm channels
+	// 2.1 duplicated perm channel
+	config.PermChannels = []PermChannel{
+		{PortId: "transfer", ChannelId: "channel-0"},
+		{PortId: "transfer", ChannelId: "channel-0"},
+	}
+	require.ErrorIs(t, config.ValidateWithNoAddrValidation(), ErrInvalidPermChannel)
+
+	// 2.2 invalid channel id
+	config.PermChannels = []PermChannel{{PortId: "transfer", ChannelId: ""}}
+	require.ErrorIs(t, config.ValidateWithNoAddrValidation(), ErrInvalidPermChannel)
+	config.PermChannels = []PermChannel{{PortId: "transfer", ChannelId: "channel-0"}}
+	require.NoError(t, config.ValidateWithNoAddrValidation())
+
+	// 3. perm channel admin
+	// 3.1 account role without address
+	config.PermChannelAdmin = PermChannelAdmin{Role: PermChannelAdmin_ACCOUNT}
+	require.ErrorIs(t, config.ValidateWithNoAddrValidation(), ErrInvalidPermChannelAdmin)
+
+	// 3.2 unknown role
+	config.PermChannelAdmin = PermChannelAdmin{Role: 100}
+	require.ErrorIs(t, config.ValidateWithNoAddrValidation(), ErrInvalidPermChannelAdmin)
+}
+
+func Test_PermChannelAdminAddress(t *testing.T) {
+	config := BridgeConfig{
+		Proposer:   "proposer",
+		Challenger: "challenger",
+	}
+
+	require.Equal(t, "challenger", config.PermChannelAdminAddress())
+
+	config.PermChannelAdmin = PermChannelAdmin{Role: PermChannelAdmin_PROPOSER}
+	require.Equal(t, "proposer", config.PermChannelAdminAddress())
+
+	config.PermChannelAdmin = PermChannelAdmin{Role: PermChannelAdmin_ACCOUNT, Address: "admin"}
+	require.Equal(t, "admin", config.PermChannelAdminAddress())
+
+	bz, err := json.Marshal(config.PermChannelAdmin)
+	require.NoError(t, err)
+	require.Equal(t, `{"role":"ACCOUNT","address":"admin"}`, string(bz))
+
+	var admin PermChannelAdmin
+	require.NoError(t, json.Unmarshal([]byte(`{"role":"proposer"}`), &admin))
+	require.Equal(t, PermChannelAdmin{Role: PermChannelAdmin_PROPOSER}, admin)
 }
 
 func TestGoGoProtoJsonPB(t *testing.T) {
@@ -30,9 +30,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateOracleConfig{}, "ophost/MsgUpdateOracleConfig")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateFinalizationPeriod{}, "ophost/MsgUpdateFinalizationPeriod")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterMigrationInfo{}, "ophost/MsgRegisterMigrationInfo")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMigrationToggles{}, "ophost/MsgUpdateMigrationToggles")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterAttestorSet{}, "ophost/MsgRegisterAttestorSet")
 	legacy.RegisterAminoMsg(cdc, &MsgAddAttestor{}, "ophost/MsgAddAttestor")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveAttestor{}, "ophost/MsgRemoveAttestor")
+	legacy.RegisterAminoMsg(cdc, &MsgAddPermChannel{}, "ophost/MsgAddPermChannel")
+	legacy.RegisterAminoMsg(cdc, &MsgRemovePermChannel{}, "ophost/MsgRemovePermChannel")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdatePermChannelAdmin{}, "ophost/MsgUpdatePermChannelAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitForcedTx{}, "ophost/MsgSubmitForcedTx")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateForcedInclusionPeriod{}, "ophost/MsgUpdateForcedInclusionPeriod")
 
 	cdc.RegisterConcrete(Params{}, "ophost/Params", nil)
 	cdc.RegisterConcrete(&BridgeAccount{}, "ophost/BridgeAccount", nil)
@@ -56,9 +62,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateOracleConfig{},
 		&MsgUpdateFinalizationPeriod{},
 		&MsgRegisterMigrationInfo{},
+		&MsgUpdateMigrationToggles{},
 		&MsgRegisterAttestorSet{},
 		&MsgAddAttestor{},
 		&MsgRemoveAttestor{},
+		&MsgAddPermChannel{},
+		&MsgRemovePermChannel{},
+		&MsgUpdatePermChannelAdmin{},
+		&MsgSubmitForcedTx{},
+		&MsgUpdateForcedInclusionPeriod{},
 	)
 
 	// auth account registration
@@ -76,6 +88,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 
 var (
 	anyResolver codectypes.InterfaceRegistry
+	protoCodec  = codec.NewProtoCodec(anyResolver)
 )
 
 func init() {
@@ -107,3 +120,8 @@ func protoMarshalJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, e
 
 	return buf.Bytes(), nil
 }
+
+// unmarshalProtoJSON unmarshals JSON-encoded bytes into a protobuf message.
+func unmarshalProtoJSON(bz []byte, ptr proto.Message) error {
+	return protoCodec.UnmarshalJSON(bz, ptr)
+}
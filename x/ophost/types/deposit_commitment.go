@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// GenerateDepositCommitment returns the commitment of the deposit, which is stored in ophost
+// state to prove the deposit on L2 with the L1 light client.
+func GenerateDepositCommitment(bridgeId uint64, l1Sequence uint64, height uint64, from string, to string, baseDenom string, amount math.Int, data []byte) [32]byte {
+	seed := []byte{}
+	seed = binary.BigEndian.AppendUint64(seed, bridgeId)
+	seed = binary.BigEndian.AppendUint64(seed, l1Sequence)
+	seed = binary.BigEndian.AppendUint64(seed, height)
+
+	// variable length
+	fromDigest := sha3.Sum256([]byte(from))
+	seed = append(seed, fromDigest[:]...) // put utf8 encoded address
+	// variable length
+	toDigest := sha3.Sum256([]byte(to))
+	seed = append(seed, toDigest[:]...) // put utf8 encoded address
+	// variable length
+	denomDigest := sha3.Sum256([]byte(baseDenom))
+	seed = append(seed, denomDigest[:]...)
+	// variable length
+	amountDigest := sha3.Sum256([]byte(amount.String()))
+	seed = append(seed, amountDigest[:]...)
+	// variable length
+	dataDigest := sha3.Sum256(data)
+	seed = append(seed, dataDigest[:]...)
+
+	return sha3.Sum256(seed)
+}
+
+// Validate performs basic validation of the deposit commitment.
+func (c DepositCommitment) Validate() error {
+	if c.Sequence == 0 {
+		return ErrInvalidSequence
+	}
+
+	if len(c.Commitment) != 32 {
+		return ErrInvalidHashLength.Wrap("deposit commitment")
+	}
+
+	return nil
+}
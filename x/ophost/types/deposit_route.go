@@ -0,0 +1,112 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// DepositRouteSenderPrefix is the address hash prefix of the intermediate sender, which
+// executes the contract call route on behalf of the L1 depositor.
+const DepositRouteSenderPrefix = "opinit-deposit-route"
+
+// DepositRouteMemo is the unsigned route payload of the deposit data. Unlike the signed
+// tx payload, the route is executed on L2 without any signature of the depositor.
+//
+// Example:
+//
+//	{"route": {"ibc_transfer": {"source_port": "transfer", "source_channel": "channel-0", "receiver": "cosmos1..."}}}
+type DepositRouteMemo struct {
+	Route *DepositRoute `json:"route"`
+}
+
+// DepositRoute defines where the deposited tokens are forwarded on L2. Exactly one of
+// the routes must be set.
+type DepositRoute struct {
+	IBCTransfer  *IBCTransferRoute  `json:"ibc_transfer,omitempty"`
+	ContractCall *ContractCallRoute `json:"contract_call,omitempty"`
+}
+
+// IBCTransferRoute forwards the deposited tokens from the deposit recipient over IBC.
+type IBCTransferRoute struct {
+	SourcePort    string `json:"source_port"`
+	SourceChannel string `json:"source_channel"`
+	Receiver      string `json:"receiver"`
+	Memo          string `json:"memo,omitempty"`
+	// TimeoutTimestamp is the absolute timeout in unix nanoseconds. Zero uses
+	// DefaultPacketTimeoutTimestamp from the L2 block time.
+	TimeoutTimestamp uint64 `json:"timeout_timestamp,omitempty"`
+}
+
+// ContractCallRoute executes the messages after the deposited tokens are sent to the
+// deposit recipient. The messages are JSON encoded with their type urls and must be
+// signed only by the intermediate sender of the depositor.
+type ContractCallRoute struct {
+	Msgs []json.RawMessage `json:"msgs"`
+}
+
+// ParseDepositRouteMemo parses the deposit data as a route memo. It returns false if the
+// data is not a route memo, e.g. a signed tx.
+func ParseDepositRouteMemo(data []byte) (DepositRouteMemo, bool) {
+	var memo DepositRouteMemo
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return memo, false
+	}
+
+	if err := json.Unmarshal(data, &memo); err != nil || memo.Route == nil {
+		return memo, false
+	}
+
+	return memo, true
+}
+
+// Validate performs basic validation of the deposit route.
+func (route DepositRoute) Validate() error {
+	switch {
+	case route.IBCTransfer != nil && route.ContractCall != nil:
+		return ErrInvalidData.Wrap("only one deposit route can be set")
+	case route.IBCTransfer != nil:
+		return route.IBCTransfer.Validate()
+	case route.ContractCall != nil:
+		return route.ContractCall.Validate()
+	default:
+		return ErrInvalidData.Wrap("empty deposit route")
+	}
+}
+
+// Validate performs basic validation of the ibc transfer route.
+func (route IBCTransferRoute) Validate() error {
+	if err := host.PortIdentifierValidator(route.SourcePort); err != nil {
+		return ErrInvalidData.Wrapf("invalid source port: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(route.SourceChannel); err != nil {
+		return ErrInvalidData.Wrapf("invalid source channel: %s", err)
+	}
+
+	// cannot validate receiver as it can be any format of address based on the chain.
+	if len(route.Receiver) == 0 {
+		return ErrInvalidData.Wrap("empty receiver")
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the contract call route.
+func (route ContractCallRoute) Validate() error {
+	if len(route.Msgs) == 0 {
+		return ErrInvalidData.Wrap("empty contract call messages")
+	}
+
+	return nil
+}
+
+// DepositRouteIntermediateSender returns the intermediate sender address of the L1
+// depositor, which signs the messages of the contract call route.
+func DepositRouteIntermediateSender(from string) sdk.AccAddress {
+	return address.Hash(DepositRouteSenderPrefix, []byte(from))
+}
@@ -26,4 +26,10 @@ var (
 	ErrBridgeAlreadyDisabled      = errorsmod.Register(ModuleName, 19, "bridge already disabled")
 	ErrBridgeDisabled             = errorsmod.Register(ModuleName, 20, "bridge disabled")
 	ErrInvalidChannelId           = errorsmod.Register(ModuleName, 21, "invalid channel id")
+	ErrInvalidPermChannel         = errorsmod.Register(ModuleName, 22, "invalid perm channel")
+	ErrInvalidPermChannelAdmin    = errorsmod.Register(ModuleName, 23, "invalid perm channel admin")
+	ErrPermChannelAlreadyExists   = errorsmod.Register(ModuleName, 24, "perm channel already exists")
+	ErrPermChannelNotFound        = errorsmod.Register(ModuleName, 25, "perm channel not found")
+	ErrForcedInclusionDisabled    = errorsmod.Register(ModuleName, 26, "forced inclusion disabled")
+	ErrForcedInclusionViolated    = errorsmod.Register(ModuleName, 27, "forced tx not included within the forced inclusion period")
 )
@@ -13,11 +13,25 @@ const (
 	EventTypeUpdateMetadata          = "update_metadata"
 	EventTypeUpdateOracle            = "update_oracle"
 	EventTypeUpdateChannelId         = "update_channel_id"
+	EventTypeAddPermChannel          = "add_perm_channel"
+	EventTypeRemovePermChannel       = "remove_perm_channel"
+	EventTypeUpdatePermChannelAdmin  = "update_perm_channel_admin"
 	EventTypeRegisterMigrationInfo   = "register_migration_info"
+	EventTypeUpdateMigrationToggles  = "update_migration_toggles"
 	EventTypeRegisterAttestorSet     = "register_attestor_set"
 	EventTypeAddAttestor             = "add_attestor"
 	EventTypeRemoveAttestor          = "remove_attestor"
 	EventTypeAttestorSetPacketSent   = "attestor_set_packet_sent"
+	EventTypeOraclePricePacketSent   = "oracle_price_packet_sent"
+	EventTypeOraclePricePacketAck    = "oracle_price_packet_ack"
+	EventTypeOraclePriceTimeout      = "oracle_price_packet_timeout"
+	EventTypeL2StatusReport          = "l2_status_report"
+	EventTypeWithdrawalsFinished     = "bridge_withdrawals_finished"
+	EventTypeSubmitForcedTx          = "submit_forced_tx"
+	EventTypeForcedTxPacketAck       = "forced_tx_packet_ack"
+	EventTypeForcedTxTimeout         = "forced_tx_packet_timeout"
+	EventTypeUpdateForcedInclusion   = "update_forced_inclusion_period"
+	EventTypeBridgeHookFailed        = "bridge_hook_failed"
 	EventTypePacket                  = "ophost_packet"
 	EventTypeTimeout                 = "timeout"
 
@@ -42,12 +56,29 @@ const (
 	AttributeKeyFinalizedOutputIndex   = "finalized_output_index"
 	AttributeKeyFinalizedL2BlockNumber = "finalized_l2_block_number"
 	AttributeKeyOracleEnabled          = "oracle_enabled"
+	AttributeKeyOraclePushInterval     = "oracle_push_interval"
+	AttributeKeyNumCurrencyPair        = "num_currency_pair"
+	AttributeKeySuccess                = "success"
 	AttributeKeyChannelId              = "channel_id"
+	AttributeKeyPortId                 = "port_id"
+	AttributeKeyPermChannelAdminRole   = "perm_channel_admin_role"
+	AttributeKeyPermChannelAdmin       = "perm_channel_admin"
 	AttributeKeyIbcChannelId           = "ibc_channel_id"
 	AttributeKeyIbcPortId              = "ibc_port_id"
 	AttributeKeyAttestorAddress        = "attestor_address"
 	AttributeKeyAttestorSetSize        = "attestor_set_size"
 	AttributeKeyL1BlockHeight          = "l1_block_height"
+	AttributeKeyL2BlockHeight          = "l2_block_height"
+	AttributeKeyNumValidators          = "num_validators"
+	AttributeKeyNextL2Sequence         = "next_l2_sequence"
 	AttributeKeyAck                    = "acknowledgement"
 	AttributeKeyAckError               = "error"
+	AttributeKeySender                 = "sender"
+	AttributeKeyForcedTxSequence       = "forced_tx_sequence"
+	AttributeKeyForcedInclusionPeriod  = "forced_inclusion_period"
+	AttributeKeyNextForcedTxSequence   = "next_forced_tx_sequence"
+	AttributeKeyNextL1Sequence         = "next_l1_sequence"
+	AttributeKeyMigrationPaused        = "migration_paused"
+	AttributeKeyHook                   = "hook"
+	AttributeKeyReason                 = "reason"
 )
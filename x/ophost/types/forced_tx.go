@@ -0,0 +1,26 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+)
+
+// Validate performs basic validation of the forced tx.
+func (tx ForcedTx) Validate(ac address.Codec) error {
+	if tx.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	if tx.Sequence == 0 {
+		return ErrInvalidSequence
+	}
+
+	if _, err := ac.StringToBytes(tx.Sender); err != nil {
+		return err
+	}
+
+	if len(tx.Tx) == 0 {
+		return ErrInvalidData.Wrap("empty tx")
+	}
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 const (
 	DefaultBridgeIdStart   = 1
 	DefaultL1SequenceStart = 1
+
+	DefaultForcedTxSequenceStart = 1
 )
 
 // NewGenesisState creates a new GenesisState instance
@@ -23,10 +25,11 @@ func NewGenesisState(params Params, bridges []Bridge, nextBridgeId uint64, migra
 // DefaultGenesisState gets the raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:         DefaultParams(),
-		Bridges:        []Bridge{},
-		NextBridgeId:   DefaultBridgeIdStart,
-		MigrationInfos: []MigrationInfo{},
+		Params:            DefaultParams(),
+		Bridges:           []Bridge{},
+		NextBridgeId:      DefaultBridgeIdStart,
+		MigrationInfos:    []MigrationInfo{},
+		MigrationStatuses: []MigrationStatus{},
 	}
 }
 
@@ -71,6 +74,26 @@ func ValidateGenesis(data *GenesisState, ac address.Codec, vc address.Codec) err
 			}
 		}
 
+		for _, depositCommitment := range bridge.DepositCommitments {
+			if depositCommitment.Sequence >= bridge.NextL1Sequence {
+				return ErrInvalidSequence
+			}
+
+			if err := depositCommitment.Validate(); err != nil {
+				return err
+			}
+		}
+
+		for _, forcedTx := range bridge.ForcedTxs {
+			if forcedTx.BridgeId != bridge.BridgeId || forcedTx.Sequence >= bridge.NextForcedTxSequence {
+				return ErrInvalidSequence
+			}
+
+			if err := forcedTx.Validate(ac); err != nil {
+				return err
+			}
+		}
+
 		if len(bridge.BatchInfos) == 0 {
 			return ErrEmptyBatchInfo
 		}
@@ -92,5 +115,11 @@ func ValidateGenesis(data *GenesisState, ac address.Codec, vc address.Codec) err
 		}
 	}
 
+	for _, migrationStatus := range data.MigrationStatuses {
+		if err := migrationStatus.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return data.Params.Validate()
 }
@@ -11,9 +11,11 @@ import (
 	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
-var _ ophosttypes.BridgeHook = BridgeHook{}
+var _ ophosttypes.ExtendedBridgeHook = BridgeHook{}
 
 type BridgeHook struct {
+	ophosttypes.BaseBridgeLifecycleHook
+
 	IBCChannelKeeper ChannelKeeper
 	IBCPermKeeper    PermKeeper
 	ac               address.Codec
@@ -31,7 +33,11 @@ type PermKeeper interface {
 }
 
 func NewBridgeHook(channelKeeper ChannelKeeper, permKeeper PermKeeper, ac address.Codec) BridgeHook {
-	return BridgeHook{channelKeeper, permKeeper, ac}
+	return BridgeHook{
+		IBCChannelKeeper: channelKeeper,
+		IBCPermKeeper:    permKeeper,
+		ac:               ac,
+	}
 }
 
 func (h BridgeHook) BridgeCreated(
@@ -39,22 +45,20 @@ func (h BridgeHook) BridgeCreated(
 	bridgeId uint64,
 	bridgeConfig ophosttypes.BridgeConfig,
 ) error {
-	hasPermChannels, metadata := hasPermChannels(bridgeConfig.Metadata)
-	if !hasPermChannels {
+	permChannels := PermChannels(bridgeConfig)
+	if len(permChannels) == 0 {
 		return nil
 	}
 
-	challenger, err := h.ac.StringToBytes(bridgeConfig.Challenger)
+	admin, err := h.ac.StringToBytes(bridgeConfig.PermChannelAdminAddress())
 	if err != nil {
 		return err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	for _, permChannel := range metadata.PermChannels {
-		portID, channelID := permChannel.PortID, permChannel.ChannelID
-
-		// register challenger as channel admin
-		if err := h.registerChannelAdmin(sdkCtx, portID, channelID, challenger); err != nil {
+	for _, permChannel := range permChannels {
+		// register the admin as channel admin
+		if err := h.registerChannelAdmin(sdkCtx, permChannel.PortId, permChannel.ChannelId, admin); err != nil {
 			return err
 		}
 	}
@@ -67,27 +71,12 @@ func (h BridgeHook) BridgeChallengerUpdated(
 	bridgeId uint64,
 	bridgeConfig ophosttypes.BridgeConfig,
 ) error {
-	hasPermChannels, metadata := hasPermChannels(bridgeConfig.Metadata)
-	if !hasPermChannels {
+	if bridgeConfig.PermChannelAdmin.Role != ophosttypes.PermChannelAdmin_CHALLENGER {
 		return nil
 	}
 
-	challenger, err := h.ac.StringToBytes(bridgeConfig.Challenger)
-	if err != nil {
-		return err
-	}
-
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	for _, permChannel := range metadata.PermChannels {
-		portID, channelID := permChannel.PortID, permChannel.ChannelID
-
-		// update channel admin to the new challenger
-		if err := h.IBCPermKeeper.SetAdmin(sdkCtx, portID, channelID, challenger); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	// update channel admin to the new challenger
+	return h.updateChannelAdmin(ctx, bridgeConfig)
 }
 
 func (h BridgeHook) BridgeProposerUpdated(
@@ -95,7 +84,12 @@ func (h BridgeHook) BridgeProposerUpdated(
 	bridgeId uint64,
 	bridgeConfig ophosttypes.BridgeConfig,
 ) error {
-	return nil
+	if bridgeConfig.PermChannelAdmin.Role != ophosttypes.PermChannelAdmin_PROPOSER {
+		return nil
+	}
+
+	// update channel admin to the new proposer
+	return h.updateChannelAdmin(ctx, bridgeConfig)
 }
 
 // BridgeBatchInfoUpdated implements types.BridgeHook.
@@ -112,29 +106,91 @@ func (h BridgeHook) BridgeMetadataUpdated(
 	bridgeId uint64,
 	bridgeConfig ophosttypes.BridgeConfig,
 ) error {
-	hasPermChannels, metadata := hasPermChannels(bridgeConfig.Metadata)
-	if !hasPermChannels {
+	return h.registerMissingChannelAdmins(ctx, bridgeConfig)
+}
+
+// BridgePermChannelsUpdated implements types.BridgeLifecycleHook.
+func (h BridgeHook) BridgePermChannelsUpdated(
+	ctx context.Context,
+	bridgeId uint64,
+	bridgeConfig ophosttypes.BridgeConfig,
+) error {
+	return h.registerMissingChannelAdmins(ctx, bridgeConfig)
+}
+
+// BridgePermChannelAdminUpdated implements types.BridgeLifecycleHook.
+func (h BridgeHook) BridgePermChannelAdminUpdated(
+	ctx context.Context,
+	bridgeId uint64,
+	bridgeConfig ophosttypes.BridgeConfig,
+) error {
+	return h.updateChannelAdmin(ctx, bridgeConfig)
+}
+
+// BridgePermChannelRemoved implements types.BridgeLifecycleHook. It clears the ibcperm admin
+// of the removed channel, so the admin is no longer managed by the bridge.
+func (h BridgeHook) BridgePermChannelRemoved(
+	ctx context.Context,
+	bridgeId uint64,
+	portId string,
+	channelId string,
+) error {
+	return h.IBCPermKeeper.SetAdmin(ctx, portId, channelId, sdk.AccAddress{})
+}
+
+// registerMissingChannelAdmins registers the admin for the perm channels which are not
+// registered yet.
+func (h BridgeHook) registerMissingChannelAdmins(
+	ctx context.Context,
+	bridgeConfig ophosttypes.BridgeConfig,
+) error {
+	permChannels := PermChannels(bridgeConfig)
+	if len(permChannels) == 0 {
 		return nil
 	}
 
-	challenger, err := h.ac.StringToBytes(bridgeConfig.Challenger)
+	admin, err := h.ac.StringToBytes(bridgeConfig.PermChannelAdminAddress())
 	if err != nil {
 		return err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	for _, permChannel := range metadata.PermChannels {
-		portID, channelID := permChannel.PortID, permChannel.ChannelID
+	for _, permChannel := range permChannels {
+		portID, channelID := permChannel.PortId, permChannel.ChannelId
 
-		// check if the challenger is already registered as a channel admin
-		if hasPerm, err := h.IBCPermKeeper.HasAdminPermission(ctx, portID, channelID, challenger); err != nil {
+		// check if the admin is already registered as a channel admin
+		if hasPerm, err := h.IBCPermKeeper.HasAdminPermission(ctx, portID, channelID, admin); err != nil {
 			return err
 		} else if hasPerm {
 			continue
 		}
 
-		// register challenger as channel admin
-		if err := h.registerChannelAdmin(sdkCtx, portID, channelID, challenger); err != nil {
+		// register the admin as channel admin
+		if err := h.registerChannelAdmin(sdkCtx, portID, channelID, admin); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateChannelAdmin sets the current admin of the bridge to all the perm channels.
+func (h BridgeHook) updateChannelAdmin(
+	ctx context.Context,
+	bridgeConfig ophosttypes.BridgeConfig,
+) error {
+	permChannels := PermChannels(bridgeConfig)
+	if len(permChannels) == 0 {
+		return nil
+	}
+
+	admin, err := h.ac.StringToBytes(bridgeConfig.PermChannelAdminAddress())
+	if err != nil {
+		return err
+	}
+
+	for _, permChannel := range permChannels {
+		if err := h.IBCPermKeeper.SetAdmin(ctx, permChannel.PortId, permChannel.ChannelId, admin); err != nil {
 			return err
 		}
 	}
@@ -160,7 +216,7 @@ func (h BridgeHook) registerChannelAdmin(
 		return channeltypes.ErrChannelExists.Wrap("cannot register ibcperm admin for the channel in use")
 	}
 
-	// register challenger as channel admin
+	// register channel admin
 	if err := h.IBCPermKeeper.SetAdmin(ctx, portID, channelID, admin); err != nil {
 		return err
 	}
@@ -262,3 +262,80 @@ func Test_BridgeHook_MetadataUpdated(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, ok)
 }
+
+func Test_BridgeHook_PermChannels(t *testing.T) {
+	ctx, h := setup()
+
+	addr := acc_addr()
+	config := ophosttypes.BridgeConfig{
+		Challenger:       addr[0].String(),
+		Proposer:         addr[1].String(),
+		PermChannels:     []ophosttypes.PermChannel{{PortId: "transfer", ChannelId: "channel-0"}},
+		PermChannelAdmin: ophosttypes.PermChannelAdmin{Role: ophosttypes.PermChannelAdmin_PROPOSER},
+	}
+	err := h.BridgeCreated(ctx, 1, config)
+	require.NoError(t, err)
+
+	// proposer is registered as channel admin
+	ok, err := h.IBCPermKeeper.HasAdminPermission(ctx, "transfer", "channel-0", addr[1])
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// cannot take non-1 sequence channel
+	config.PermChannels = append(config.PermChannels, ophosttypes.PermChannel{PortId: "transfer", ChannelId: "channel-1"})
+	err = h.BridgePermChannelsUpdated(ctx, 1, config)
+	require.ErrorIs(t, err, channeltypes.ErrChannelExists)
+
+	config.PermChannels[1].ChannelId = "channel-2"
+	err = h.BridgePermChannelsUpdated(ctx, 1, config)
+	require.NoError(t, err)
+
+	ok, err = h.IBCPermKeeper.HasAdminPermission(ctx, "transfer", "channel-2", addr[1])
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// challenger update does not change the admin of the proposer role
+	newAddr := acc_addr()
+	config.Challenger = newAddr[0].String()
+	err = h.BridgeChallengerUpdated(ctx, 1, config)
+	require.NoError(t, err)
+
+	ok, err = h.IBCPermKeeper.HasAdminPermission(ctx, "transfer", "channel-0", addr[1])
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// proposer update changes the admin
+	config.Proposer = newAddr[1].String()
+	err = h.BridgeProposerUpdated(ctx, 1, config)
+	require.NoError(t, err)
+
+	for _, channelID := range []string{"channel-0", "channel-2"} {
+		ok, err = h.IBCPermKeeper.HasAdminPermission(ctx, "transfer", channelID, newAddr[1])
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	// admin update to a fixed account
+	account := acc_addr()[0]
+	config.PermChannelAdmin = ophosttypes.PermChannelAdmin{Role: ophosttypes.PermChannelAdmin_ACCOUNT, Address: account.String()}
+	err = h.BridgePermChannelAdminUpdated(ctx, 1, config)
+	require.NoError(t, err)
+
+	for _, channelID := range []string{"channel-0", "channel-2"} {
+		ok, err = h.IBCPermKeeper.HasAdminPermission(ctx, "transfer", channelID, account)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	// removing the perm channel clears the admin
+	err = h.BridgePermChannelRemoved(ctx, 1, "transfer", "channel-2")
+	require.NoError(t, err)
+
+	ok, err = h.IBCPermKeeper.HasAdminPermission(ctx, "transfer", "channel-2", account)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = h.IBCPermKeeper.HasAdminPermission(ctx, "transfer", "channel-0", account)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
@@ -3,6 +3,8 @@ package hook
 import (
 	"encoding/json"
 	"strings"
+
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 const permsMetadataKey = "perm_channels"
@@ -29,6 +31,75 @@ func hasPermChannels(metadata []byte) (hasPerms bool, data PermsMetadata) {
 	return true, data
 }
 
+// PermChannels returns the perm channels of the bridge, including the channels
+// registered in the deprecated metadata.
+func PermChannels(config ophosttypes.BridgeConfig) []ophosttypes.PermChannel {
+	permChannels := config.PermChannels
+
+	hasPerms, metadata := hasPermChannels(config.Metadata)
+	if !hasPerms {
+		return permChannels
+	}
+
+	permChannels = append([]ophosttypes.PermChannel{}, permChannels...)
+	for _, permChannel := range metadata.PermChannels {
+		if config.HasPermChannel(permChannel.PortID, permChannel.ChannelID) {
+			continue
+		}
+
+		permChannels = append(permChannels, ophosttypes.PermChannel{
+			PortId:    permChannel.PortID,
+			ChannelId: permChannel.ChannelID,
+		})
+	}
+
+	return permChannels
+}
+
+// RemovePermChannel removes the channel from both the perm channels of the bridge and the
+// deprecated metadata. It returns false if the channel is not a perm channel of the bridge.
+func RemovePermChannel(config ophosttypes.BridgeConfig, portID, channelID string) (ophosttypes.BridgeConfig, bool) {
+	found := false
+
+	permChannels := make([]ophosttypes.PermChannel, 0, len(config.PermChannels))
+	for _, permChannel := range config.PermChannels {
+		if permChannel.PortId == portID && permChannel.ChannelId == channelID {
+			found = true
+			continue
+		}
+
+		permChannels = append(permChannels, permChannel)
+	}
+	config.PermChannels = permChannels
+
+	hasPerms, metadata := hasPermChannels(config.Metadata)
+	if !hasPerms {
+		return config, found
+	}
+
+	metadataChannels := make([]PortChannelID, 0, len(metadata.PermChannels))
+	for _, permChannel := range metadata.PermChannels {
+		if permChannel.PortID == portID && permChannel.ChannelID == channelID {
+			found = true
+			continue
+		}
+
+		metadataChannels = append(metadataChannels, permChannel)
+	}
+
+	if len(metadataChannels) != len(metadata.PermChannels) {
+		bz, err := json.Marshal(PermsMetadata{PermChannels: metadataChannels})
+		if err != nil {
+			// cannot happen; the metadata only contains strings
+			panic(err)
+		}
+
+		config.Metadata = bz
+	}
+
+	return config, found
+}
+
 // jsonStringHasKey parses the metadata string as a json object and checks if it contains the key.
 func jsonStringHasKey(metadata, key string) bool {
 	if len(metadata) == 0 {
@@ -1,6 +1,10 @@
 package types
 
-import "context"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type BridgeHook interface {
 	BridgeCreated(
@@ -107,3 +111,278 @@ func (hooks BridgeHooks) BridgeMetadataUpdated(
 
 	return nil
 }
+
+// BridgeLifecycleHook defines the hooks for the output and transfer lifecycle of bridges.
+// An error from TokenDeposited or WithdrawalFinalized does not revert the transfer; the
+// state changes of the hook are discarded and the failure is emitted instead.
+type BridgeLifecycleHook interface {
+	OutputProposed(
+		ctx context.Context,
+		bridgeId uint64,
+		outputIndex uint64,
+		output Output,
+	) error
+	OutputDeleted(
+		ctx context.Context,
+		bridgeId uint64,
+		outputIndex uint64,
+	) error
+	TokenDeposited(
+		ctx context.Context,
+		bridgeId uint64,
+		l1Sequence uint64,
+		from string,
+		to string,
+		amount sdk.Coin,
+		data []byte,
+	) error
+	WithdrawalFinalized(
+		ctx context.Context,
+		bridgeId uint64,
+		outputIndex uint64,
+		l2Sequence uint64,
+		from string,
+		to string,
+		amount sdk.Coin,
+	) error
+	BridgeDisabled(
+		ctx context.Context,
+		bridgeId uint64,
+		bridgeConfig BridgeConfig,
+	) error
+	AttestorSetUpdated(
+		ctx context.Context,
+		bridgeId uint64,
+		attestorSet []Attestor,
+	) error
+	BridgePermChannelsUpdated(
+		ctx context.Context,
+		bridgeId uint64,
+		bridgeConfig BridgeConfig,
+	) error
+	BridgePermChannelAdminUpdated(
+		ctx context.Context,
+		bridgeId uint64,
+		bridgeConfig BridgeConfig,
+	) error
+	BridgePermChannelRemoved(
+		ctx context.Context,
+		bridgeId uint64,
+		portId string,
+		channelId string,
+	) error
+}
+
+// ExtendedBridgeHook is a BridgeHook which also subscribes to the bridge lifecycle.
+type ExtendedBridgeHook interface {
+	BridgeHook
+	BridgeLifecycleHook
+}
+
+// NewExtendedBridgeHook adapts a BridgeHook to ExtendedBridgeHook. The lifecycle hooks
+// are no-op unless the given hook implements BridgeLifecycleHook.
+func NewExtendedBridgeHook(hook BridgeHook) ExtendedBridgeHook {
+	if h, ok := hook.(ExtendedBridgeHook); ok {
+		return h
+	}
+
+	return bridgeHookAdapter{BridgeHook: hook}
+}
+
+// bridgeHookAdapter wraps a legacy BridgeHook with no-op lifecycle hooks.
+type bridgeHookAdapter struct {
+	BridgeHook
+	BaseBridgeLifecycleHook
+}
+
+// BaseBridgeLifecycleHook implements BridgeLifecycleHook with no-op methods. It can be
+// embedded to subscribe only to a subset of the lifecycle hooks.
+type BaseBridgeLifecycleHook struct{}
+
+var _ BridgeLifecycleHook = BaseBridgeLifecycleHook{}
+
+func (BaseBridgeLifecycleHook) OutputProposed(context.Context, uint64, uint64, Output) error {
+	return nil
+}
+
+func (BaseBridgeLifecycleHook) OutputDeleted(context.Context, uint64, uint64) error {
+	return nil
+}
+
+func (BaseBridgeLifecycleHook) TokenDeposited(context.Context, uint64, uint64, string, string, sdk.Coin, []byte) error {
+	return nil
+}
+
+func (BaseBridgeLifecycleHook) WithdrawalFinalized(context.Context, uint64, uint64, uint64, string, string, sdk.Coin) error {
+	return nil
+}
+
+func (BaseBridgeLifecycleHook) BridgeDisabled(context.Context, uint64, BridgeConfig) error {
+	return nil
+}
+
+func (BaseBridgeLifecycleHook) AttestorSetUpdated(context.Context, uint64, []Attestor) error {
+	return nil
+}
+
+func (BaseBridgeLifecycleHook) BridgePermChannelsUpdated(context.Context, uint64, BridgeConfig) error {
+	return nil
+}
+
+func (BaseBridgeLifecycleHook) BridgePermChannelAdminUpdated(context.Context, uint64, BridgeConfig) error {
+	return nil
+}
+
+func (BaseBridgeLifecycleHook) BridgePermChannelRemoved(context.Context, uint64, string, string) error {
+	return nil
+}
+
+var _ ExtendedBridgeHook = BridgeHooks{}
+
+// lifecycleHooks returns the subscribers which implement BridgeLifecycleHook.
+func (hooks BridgeHooks) lifecycleHooks() []BridgeLifecycleHook {
+	lifecycleHooks := make([]BridgeLifecycleHook, 0, len(hooks))
+	for _, h := range hooks {
+		if lh, ok := h.(BridgeLifecycleHook); ok {
+			lifecycleHooks = append(lifecycleHooks, lh)
+		}
+	}
+
+	return lifecycleHooks
+}
+
+func (hooks BridgeHooks) OutputProposed(
+	ctx context.Context,
+	bridgeId uint64,
+	outputIndex uint64,
+	output Output,
+) error {
+	for _, h := range hooks.lifecycleHooks() {
+		if err := h.OutputProposed(ctx, bridgeId, outputIndex, output); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hooks BridgeHooks) OutputDeleted(
+	ctx context.Context,
+	bridgeId uint64,
+	outputIndex uint64,
+) error {
+	for _, h := range hooks.lifecycleHooks() {
+		if err := h.OutputDeleted(ctx, bridgeId, outputIndex); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hooks BridgeHooks) TokenDeposited(
+	ctx context.Context,
+	bridgeId uint64,
+	l1Sequence uint64,
+	from string,
+	to string,
+	amount sdk.Coin,
+	data []byte,
+) error {
+	for _, h := range hooks.lifecycleHooks() {
+		if err := h.TokenDeposited(ctx, bridgeId, l1Sequence, from, to, amount, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hooks BridgeHooks) WithdrawalFinalized(
+	ctx context.Context,
+	bridgeId uint64,
+	outputIndex uint64,
+	l2Sequence uint64,
+	from string,
+	to string,
+	amount sdk.Coin,
+) error {
+	for _, h := range hooks.lifecycleHooks() {
+		if err := h.WithdrawalFinalized(ctx, bridgeId, outputIndex, l2Sequence, from, to, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hooks BridgeHooks) BridgeDisabled(
+	ctx context.Context,
+	bridgeId uint64,
+	bridgeConfig BridgeConfig,
+) error {
+	for _, h := range hooks.lifecycleHooks() {
+		if err := h.BridgeDisabled(ctx, bridgeId, bridgeConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hooks BridgeHooks) AttestorSetUpdated(
+	ctx context.Context,
+	bridgeId uint64,
+	attestorSet []Attestor,
+) error {
+	for _, h := range hooks.lifecycleHooks() {
+		if err := h.AttestorSetUpdated(ctx, bridgeId, attestorSet); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hooks BridgeHooks) BridgePermChannelsUpdated(
+	ctx context.Context,
+	bridgeId uint64,
+	bridgeConfig BridgeConfig,
+) error {
+	for _, h := range hooks.lifecycleHooks() {
+		if err := h.BridgePermChannelsUpdated(ctx, bridgeId, bridgeConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hooks BridgeHooks) BridgePermChannelAdminUpdated(
+	ctx context.Context,
+	bridgeId uint64,
+	bridgeConfig BridgeConfig,
+) error {
+	for _, h := range hooks.lifecycleHooks() {
+		if err := h.BridgePermChannelAdminUpdated(ctx, bridgeId, bridgeConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hooks BridgeHooks) BridgePermChannelRemoved(
+	ctx context.Context,
+	bridgeId uint64,
+	portId string,
+	channelId string,
+) error {
+	for _, h := range hooks.lifecycleHooks() {
+		if err := h.BridgePermChannelRemoved(ctx, bridgeId, portId, channelId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
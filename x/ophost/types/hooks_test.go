@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+type legacyHook struct {
+	created bool
+}
+
+func (h *legacyHook) BridgeCreated(context.Context, uint64, types.BridgeConfig) error {
+	h.created = true
+	return nil
+}
+
+func (h *legacyHook) BridgeChallengerUpdated(context.Context, uint64, types.BridgeConfig) error {
+	return nil
+}
+
+func (h *legacyHook) BridgeProposerUpdated(context.Context, uint64, types.BridgeConfig) error {
+	return nil
+}
+
+func (h *legacyHook) BridgeBatchInfoUpdated(context.Context, uint64, types.BridgeConfig) error {
+	return nil
+}
+
+func (h *legacyHook) BridgeMetadataUpdated(context.Context, uint64, types.BridgeConfig) error {
+	return nil
+}
+
+type lifecycleHook struct {
+	legacyHook
+	types.BaseBridgeLifecycleHook
+
+	deposits []uint64
+	err      error
+}
+
+func (h *lifecycleHook) TokenDeposited(_ context.Context, _ uint64, l1Sequence uint64, _, _ string, _ sdk.Coin, _ []byte) error {
+	if h.err != nil {
+		return h.err
+	}
+
+	h.deposits = append(h.deposits, l1Sequence)
+	return nil
+}
+
+func Test_BridgeHooks_Lifecycle(t *testing.T) {
+	legacy := &legacyHook{}
+	subscriber1 := &lifecycleHook{}
+	subscriber2 := &lifecycleHook{}
+
+	hooks := types.NewBridgeHooks(legacy, subscriber1, subscriber2)
+	ctx := context.Background()
+
+	require.NoError(t, hooks.BridgeCreated(ctx, 1, types.BridgeConfig{}))
+	require.True(t, legacy.created)
+	require.True(t, subscriber1.created)
+	require.True(t, subscriber2.created)
+
+	// legacy hooks are skipped for the lifecycle hooks
+	require.NoError(t, hooks.TokenDeposited(ctx, 1, 1, "from", "to", sdk.NewInt64Coin("uinit", 100), nil))
+	require.Equal(t, []uint64{1}, subscriber1.deposits)
+	require.Equal(t, []uint64{1}, subscriber2.deposits)
+
+	// no-op lifecycle hooks
+	require.NoError(t, hooks.OutputProposed(ctx, 1, 1, types.Output{}))
+	require.NoError(t, hooks.BridgeDisabled(ctx, 1, types.BridgeConfig{}))
+
+	// error from a subscriber stops the dispatch
+	subscriber1.err = errors.New("failed")
+	require.Error(t, hooks.TokenDeposited(ctx, 1, 2, "from", "to", sdk.NewInt64Coin("uinit", 100), nil))
+	require.Equal(t, []uint64{1}, subscriber2.deposits)
+}
+
+func Test_NewExtendedBridgeHook(t *testing.T) {
+	ctx := context.Background()
+
+	// legacy hook is adapted with no-op lifecycle hooks
+	legacy := &legacyHook{}
+	hook := types.NewExtendedBridgeHook(legacy)
+	require.NoError(t, hook.BridgeCreated(ctx, 1, types.BridgeConfig{}))
+	require.True(t, legacy.created)
+	require.NoError(t, hook.TokenDeposited(ctx, 1, 1, "from", "to", sdk.NewInt64Coin("uinit", 100), nil))
+
+	// extended hook is used as is
+	subscriber := &lifecycleHook{}
+	hook = types.NewExtendedBridgeHook(subscriber)
+	require.NoError(t, hook.TokenDeposited(ctx, 1, 1, "from", "to", sdk.NewInt64Coin("uinit", 100), nil))
+	require.Equal(t, []uint64{1}, subscriber.deposits)
+}
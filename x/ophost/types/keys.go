@@ -1,5 +1,9 @@
 package types
 
+import (
+	"encoding/binary"
+)
+
 const (
 	// ModuleName is the name of the ophost module
 	ModuleName = "ophost"
@@ -22,13 +26,29 @@ var (
 	NextBridgeIdKey = []byte{0x11}
 	ParamsKey       = []byte{0x12}
 
-	BridgeConfigPrefix     = []byte{0x21}
-	NextL1SequencePrefix   = []byte{0x31}
-	TokenPairPrefix        = []byte{0x41}
-	OutputProposalPrefix   = []byte{0x51}
-	NextOutputIndexPrefix  = []byte{0x61}
-	ProvenWithdrawalPrefix = []byte{0x71}
-	BatchInfoPrefix        = []byte{0x81}
-	MigrationInfoPrefix    = []byte{0x91}
-	OraclePriceHashPrefix  = []byte{0xa1}
+	BridgeConfigPrefix      = []byte{0x21}
+	NextL1SequencePrefix    = []byte{0x31}
+	TokenPairPrefix         = []byte{0x41}
+	OutputProposalPrefix    = []byte{0x51}
+	NextOutputIndexPrefix   = []byte{0x61}
+	ProvenWithdrawalPrefix  = []byte{0x71}
+	BatchInfoPrefix         = []byte{0x81}
+	MigrationInfoPrefix     = []byte{0x91}
+	MigrationStatusPrefix   = []byte{0x92}
+	OraclePriceHashPrefix   = []byte{0xa1}
+	OraclePushHeightPrefix  = []byte{0xb1}
+	NextOraclePushPrefix    = []byte{0xb2}
+	OraclePushQueuePrefix   = []byte{0xb3}
+	L2StatusPrefix          = []byte{0xc1}
+	DepositCommitmentPrefix = []byte{0xd1}
+	ForcedTxPrefix          = []byte{0xe1}
+	NextForcedTxSeqPrefix   = []byte{0xe2}
 )
+
+// GetDepositCommitmentKey returns the store key of the deposit commitment, which is used as
+// the merkle path to prove the deposit on L2.
+func GetDepositCommitmentKey(bridgeId, l1Sequence uint64) []byte {
+	key := append([]byte{}, DepositCommitmentPrefix...)
+	key = binary.BigEndian.AppendUint64(key, bridgeId)
+	return binary.BigEndian.AppendUint64(key, l1Sequence)
+}
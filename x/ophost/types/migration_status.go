@@ -0,0 +1,55 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMigrationStatus returns the zero migration counters of the l1 denom.
+func NewMigrationStatus(bridgeId uint64, l1Denom string) MigrationStatus {
+	return MigrationStatus{
+		BridgeId:         bridgeId,
+		L1Denom:          l1Denom,
+		RegisteredEscrow: sdk.NewCoin(l1Denom, math.ZeroInt()),
+		Deposited:        sdk.NewCoin(l1Denom, math.ZeroInt()),
+		Withdrawn:        sdk.NewCoin(l1Denom, math.ZeroInt()),
+		PausedDeposited:  sdk.NewCoin(l1Denom, math.ZeroInt()),
+	}
+}
+
+// Migrated returns the amount of the l1 denom moved to the ibc escrow by the migration.
+func (m MigrationStatus) Migrated() sdk.Coin {
+	migrated := m.RegisteredEscrow.Add(m.Deposited)
+	if migrated.IsLT(m.Withdrawn) {
+		return sdk.NewCoin(m.L1Denom, math.ZeroInt())
+	}
+
+	return migrated.Sub(m.Withdrawn)
+}
+
+func (m MigrationStatus) Validate() error {
+	if m.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	if err := sdk.ValidateDenom(m.L1Denom); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	coins := []sdk.Coin{m.RegisteredEscrow, m.Deposited, m.Withdrawn}
+
+	// the statuses stored before the paused deposits were tracked have no paused deposit amount
+	if !m.PausedDeposited.Amount.IsNil() {
+		coins = append(coins, m.PausedDeposited)
+	}
+
+	for _, coin := range coins {
+		if !coin.IsValid() || coin.Denom != m.L1Denom {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid migration status amount: %s", coin)
+		}
+	}
+
+	return nil
+}
@@ -19,6 +19,9 @@ func (output Output) IsEmpty() bool {
 	return len(output.OutputRoot) == 0 && output.L1BlockNumber == 0 && output.L2BlockNumber == 0
 }
 
+// OutputVersion is the version of the output root built by the bridge executor.
+const OutputVersion byte = 1
+
 func GenerateOutputRoot(version byte, storageRoot []byte, latestBlockHash []byte) [32]byte {
 	seed := make([]byte, 1+32+32)
 	seed[0] = version
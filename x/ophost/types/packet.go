@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewOraclePriceUpdatePacketData constructs a new OraclePriceUpdatePacketData instance
+func NewOraclePriceUpdatePacketData(
+	bridgeId uint64, prices OraclePriceInfos, l1BlockHeight uint64, l1BlockTime int64,
+) OraclePriceUpdatePacketData {
+	packetPrices := make([]OraclePrice, len(prices))
+	for i, p := range prices {
+		packetPrices[i] = OraclePrice{
+			CurrencyPairId: p.CurrencyPairId,
+			CurrencyPair:   p.CurrencyPairString,
+			Price:          p.Price.String(),
+			Timestamp:      p.Timestamp,
+		}
+	}
+
+	return OraclePriceUpdatePacketData{
+		BridgeId:      bridgeId,
+		Prices:        packetPrices,
+		L1BlockHeight: l1BlockHeight,
+		L1BlockTime:   l1BlockTime,
+	}
+}
+
+// NewOraclePriceUpdatePacket wraps the oracle price update into an OPinitPacketData envelope
+func NewOraclePriceUpdatePacket(data OraclePriceUpdatePacketData) OPinitPacketData {
+	return OPinitPacketData{OraclePriceUpdate: &data}
+}
+
+// NewL2StatusReportPacket wraps the L2 status report into an OPinitPacketData envelope
+func NewL2StatusReportPacket(data L2StatusReportPacketData) OPinitPacketData {
+	return OPinitPacketData{L2StatusReport: &data}
+}
+
+// NewForcedTxPacket wraps the forced tx into an OPinitPacketData envelope
+func NewForcedTxPacket(forcedTx ForcedTx) OPinitPacketData {
+	return OPinitPacketData{ForcedTx: &ForcedTxPacketData{
+		BridgeId:      forcedTx.BridgeId,
+		Sequence:      forcedTx.Sequence,
+		Sender:        forcedTx.Sender,
+		Tx:            forcedTx.Tx,
+		L1BlockHeight: forcedTx.L1BlockHeight,
+	}}
+}
+
+// GetBytes is a helper for serializing OPinitPacketData
+func (pd OPinitPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&pd)) //nolint:staticcheck
+}
+
+// DecodeOPinitPacketData decodes the envelope of a packet sent over the opinit channel.
+// It fails for packets sent without the envelope, such as AttestorSetUpdatePacketData.
+func DecodeOPinitPacketData(packetData []byte) (OPinitPacketData, error) {
+	var data OPinitPacketData
+	if err := unmarshalProtoJSON(packetData, &data); err != nil {
+		return OPinitPacketData{}, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	numSet := 0
+	if data.OraclePriceUpdate != nil {
+		numSet++
+	}
+	if data.L2StatusReport != nil {
+		numSet++
+	}
+	if data.ForcedTx != nil {
+		numSet++
+	}
+	if numSet != 1 {
+		return OPinitPacketData{}, sdkerrors.ErrInvalidRequest.Wrap("opinit packet data must contain exactly one packet")
+	}
+
+	return data, nil
+}
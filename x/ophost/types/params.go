@@ -8,11 +8,13 @@ import (
 
 var (
 	DefaultRegistrationFee = sdk.Coins{}
+	DefaultForcedTxFee     = sdk.Coins{}
 )
 
 func DefaultParams() Params {
 	return Params{
 		RegistrationFee: DefaultRegistrationFee,
+		ForcedTxFee:     DefaultForcedTxFee,
 	}
 }
 
@@ -35,5 +37,9 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.ForcedTxFee.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// Validate performs basic validation of the perm channel.
+func (c PermChannel) Validate() error {
+	if err := host.PortIdentifierValidator(c.PortId); err != nil {
+		return errors.Wrapf(ErrInvalidPermChannel, "invalid port id: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+		return errors.Wrapf(ErrInvalidPermChannel, "invalid channel id: %s", err)
+	}
+
+	return nil
+}
+
+// ValidatePermChannels validates the perm channels and checks for duplicates.
+func ValidatePermChannels(permChannels []PermChannel) error {
+	seen := make(map[string]bool)
+	for i, permChannel := range permChannels {
+		if err := permChannel.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid perm channel at index %d", i)
+		}
+
+		key := permChannel.PortId + "/" + permChannel.ChannelId
+		if seen[key] {
+			return errors.Wrapf(ErrInvalidPermChannel, "duplicate perm channel: %s", key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the perm channel admin.
+func (admin PermChannelAdmin) Validate(ac address.Codec) error {
+	switch admin.Role {
+	case PermChannelAdmin_CHALLENGER, PermChannelAdmin_PROPOSER:
+		if admin.Address != "" {
+			return ErrInvalidPermChannelAdmin.Wrapf("address must be empty for the %s role", admin.Role)
+		}
+	case PermChannelAdmin_ACCOUNT:
+		if _, err := ac.StringToBytes(admin.Address); err != nil {
+			return errors.Wrap(ErrInvalidPermChannelAdmin, err.Error())
+		}
+	default:
+		return ErrInvalidPermChannelAdmin.Wrapf("invalid role: %d", admin.Role)
+	}
+
+	return nil
+}
@@ -22,9 +22,15 @@ var (
 	_ sdk.Msg = &MsgUpdateBatchInfo{}
 	_ sdk.Msg = &MsgUpdateMetadata{}
 	_ sdk.Msg = &MsgUpdateChannelId{}
+	_ sdk.Msg = &MsgAddPermChannel{}
+	_ sdk.Msg = &MsgRemovePermChannel{}
+	_ sdk.Msg = &MsgUpdatePermChannelAdmin{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgUpdateFinalizationPeriod{}
+	_ sdk.Msg = &MsgUpdateForcedInclusionPeriod{}
+	_ sdk.Msg = &MsgSubmitForcedTx{}
 	_ sdk.Msg = &MsgRegisterMigrationInfo{}
+	_ sdk.Msg = &MsgUpdateMigrationToggles{}
 	_ sdk.Msg = &MsgRegisterAttestorSet{}
 	_ sdk.Msg = &MsgAddAttestor{}
 	_ sdk.Msg = &MsgRemoveAttestor{}
@@ -220,6 +226,49 @@ func (msg MsgInitiateTokenDeposit) Validate(ac address.Codec) error {
 		return ErrInvalidData.Wrapf("data length exceeds %d", MaxDataLength)
 	}
 
+	// reject invalid route memo early, as it is not signed and executed on L2 as it is
+	if memo, ok := ParseDepositRouteMemo(msg.Data); ok {
+		if err := memo.Route.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/* MsgSubmitForcedTx */
+
+// NewMsgSubmitForcedTx creates a new MsgSubmitForcedTx instance.
+func NewMsgSubmitForcedTx(
+	sender string,
+	bridgeId uint64,
+	tx []byte,
+) *MsgSubmitForcedTx {
+	return &MsgSubmitForcedTx{
+		Sender:   sender,
+		BridgeId: bridgeId,
+		Tx:       tx,
+	}
+}
+
+// Validate performs basic MsgSubmitForcedTx message validation.
+func (msg MsgSubmitForcedTx) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	if len(msg.Tx) == 0 {
+		return ErrInvalidData.Wrap("empty tx")
+	}
+
+	if len(msg.Tx) > MaxDataLength {
+		return ErrInvalidData.Wrapf("tx length exceeds %d", MaxDataLength)
+	}
+
 	return nil
 }
 
@@ -410,11 +459,13 @@ func NewMsgUpdateOracleConfig(
 	authority string,
 	bridgeId uint64,
 	oracleEnabled bool,
+	oraclePushInterval uint64,
 ) *MsgUpdateOracleConfig {
 	return &MsgUpdateOracleConfig{
-		Authority:     authority,
-		BridgeId:      bridgeId,
-		OracleEnabled: oracleEnabled,
+		Authority:          authority,
+		BridgeId:           bridgeId,
+		OracleEnabled:      oracleEnabled,
+		OraclePushInterval: oraclePushInterval,
 	}
 }
 
@@ -462,6 +513,94 @@ func (msg MsgUpdateChannelId) Validate(accAddressCodec address.Codec) error {
 	return nil
 }
 
+/* MsgAddPermChannel */
+
+// NewMsgAddPermChannel creates a new MsgAddPermChannel instance.
+func NewMsgAddPermChannel(
+	authority string,
+	bridgeId uint64,
+	portId string,
+	channelId string,
+) *MsgAddPermChannel {
+	return &MsgAddPermChannel{
+		Authority: authority,
+		BridgeId:  bridgeId,
+		PortId:    portId,
+		ChannelId: channelId,
+	}
+}
+
+// Validate performs basic MsgAddPermChannel message validation.
+func (msg MsgAddPermChannel) Validate(accAddressCodec address.Codec) error {
+	if _, err := accAddressCodec.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	return PermChannel{PortId: msg.PortId, ChannelId: msg.ChannelId}.Validate()
+}
+
+/* MsgRemovePermChannel */
+
+// NewMsgRemovePermChannel creates a new MsgRemovePermChannel instance.
+func NewMsgRemovePermChannel(
+	authority string,
+	bridgeId uint64,
+	portId string,
+	channelId string,
+) *MsgRemovePermChannel {
+	return &MsgRemovePermChannel{
+		Authority: authority,
+		BridgeId:  bridgeId,
+		PortId:    portId,
+		ChannelId: channelId,
+	}
+}
+
+// Validate performs basic MsgRemovePermChannel message validation.
+func (msg MsgRemovePermChannel) Validate(accAddressCodec address.Codec) error {
+	if _, err := accAddressCodec.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	return PermChannel{PortId: msg.PortId, ChannelId: msg.ChannelId}.Validate()
+}
+
+/* MsgUpdatePermChannelAdmin */
+
+// NewMsgUpdatePermChannelAdmin creates a new MsgUpdatePermChannelAdmin instance.
+func NewMsgUpdatePermChannelAdmin(
+	authority string,
+	bridgeId uint64,
+	admin PermChannelAdmin,
+) *MsgUpdatePermChannelAdmin {
+	return &MsgUpdatePermChannelAdmin{
+		Authority: authority,
+		BridgeId:  bridgeId,
+		Admin:     admin,
+	}
+}
+
+// Validate performs basic MsgUpdatePermChannelAdmin message validation.
+func (msg MsgUpdatePermChannelAdmin) Validate(accAddressCodec address.Codec) error {
+	if _, err := accAddressCodec.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	return msg.Admin.Validate(accAddressCodec)
+}
+
 /* MsgUpdateMetadata */
 
 // NewMsgUpdateMetadata creates a new MsgUpdateMetadata instance.
@@ -549,6 +688,34 @@ func (msg MsgUpdateFinalizationPeriod) Validate(ac address.Codec) error {
 	return nil
 }
 
+/* MsgUpdateForcedInclusionPeriod */
+
+// NewMsgUpdateForcedInclusionPeriod creates a new MsgUpdateForcedInclusionPeriod instance.
+func NewMsgUpdateForcedInclusionPeriod(
+	authority string,
+	bridgeId uint64,
+	forcedInclusionPeriod uint64,
+) *MsgUpdateForcedInclusionPeriod {
+	return &MsgUpdateForcedInclusionPeriod{
+		Authority:             authority,
+		BridgeId:              bridgeId,
+		ForcedInclusionPeriod: forcedInclusionPeriod,
+	}
+}
+
+// Validate performs basic MsgUpdateForcedInclusionPeriod message validation.
+func (msg MsgUpdateForcedInclusionPeriod) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	return nil
+}
+
 /* MsgDisableBridge */
 
 // NewMsgDisableBridge creates a new MsgDisableBridge instance.
@@ -598,6 +765,36 @@ func (msg MsgRegisterMigrationInfo) Validate(ac address.Codec) error {
 	return msg.MigrationInfo.Validate()
 }
 
+/* MsgUpdateMigrationToggles */
+
+// NewMsgUpdateMigrationToggles creates a new MsgUpdateMigrationToggles instance.
+func NewMsgUpdateMigrationToggles(
+	authority string,
+	bridgeId uint64,
+	l1Denom string,
+	migrationPaused bool,
+) *MsgUpdateMigrationToggles {
+	return &MsgUpdateMigrationToggles{
+		Authority:       authority,
+		BridgeId:        bridgeId,
+		L1Denom:         l1Denom,
+		MigrationPaused: migrationPaused,
+	}
+}
+
+// Validate performs basic MsgUpdateMigrationToggles message validation.
+func (msg MsgUpdateMigrationToggles) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	return sdk.ValidateDenom(msg.L1Denom)
+}
+
 /* MsgRegisterAttestorSet */
 
 // NewMsgRegisterAttestorSet creates a new MsgRegisterAttestorSet instance.
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestMsgRecordBatch_Validate(t *testing.T) {
@@ -34,11 +35,11 @@ func Test_MsgUpdateOracleConfig(t *testing.T) {
 	require.NoError(t, err)
 
 	// Valid input
-	validMsg := NewMsgUpdateOracleConfig(addr, 123, true)
+	validMsg := NewMsgUpdateOracleConfig(addr, 123, true, 10)
 	require.NoError(t, validMsg.Validate(ac))
 
 	// Empty submitter
-	invalidMsg := NewMsgUpdateOracleConfig("", 123, false)
+	invalidMsg := NewMsgUpdateOracleConfig("", 123, false, 0)
 	require.Error(t, invalidMsg.Validate(ac))
 }
 
@@ -63,3 +64,35 @@ func Test_MsgUpdateFinalizationPeriod(t *testing.T) {
 	invalidMsg = NewMsgUpdateFinalizationPeriod(addr, 1, time.Second*0)
 	require.Error(t, invalidMsg.Validate(ac))
 }
+
+func Test_MsgInitiateTokenDeposit_RouteMemo(t *testing.T) {
+	ac := address.NewBech32Codec("init")
+	addr, err := ac.BytesToString(bytes.Repeat([]byte{1}, 20))
+	require.NoError(t, err)
+
+	amount := sdk.NewInt64Coin("uinit", 100)
+
+	// valid ibc transfer route
+	data := []byte(`{"route":{"ibc_transfer":{"source_port":"transfer","source_channel":"channel-0","receiver":"cosmos1receiver"}}}`)
+	require.NoError(t, NewMsgInitiateTokenDeposit(addr, 1, "to", amount, data).Validate(ac))
+
+	// valid contract call route
+	data = []byte(`{"route":{"contract_call":{"msgs":[{"@type":"/cosmos.bank.v1beta1.MsgSend"}]}}}`)
+	require.NoError(t, NewMsgInitiateTokenDeposit(addr, 1, "to", amount, data).Validate(ac))
+
+	// empty route
+	data = []byte(`{"route":{}}`)
+	require.ErrorIs(t, NewMsgInitiateTokenDeposit(addr, 1, "to", amount, data).Validate(ac), ErrInvalidData)
+
+	// multiple routes
+	data = []byte(`{"route":{"ibc_transfer":{"source_port":"transfer","source_channel":"channel-0","receiver":"cosmos1receiver"},"contract_call":{"msgs":[{}]}}}`)
+	require.ErrorIs(t, NewMsgInitiateTokenDeposit(addr, 1, "to", amount, data).Validate(ac), ErrInvalidData)
+
+	// invalid channel
+	data = []byte(`{"route":{"ibc_transfer":{"source_port":"transfer","source_channel":"","receiver":"cosmos1receiver"}}}`)
+	require.ErrorIs(t, NewMsgInitiateTokenDeposit(addr, 1, "to", amount, data).Validate(ac), ErrInvalidData)
+
+	// non-route data is not validated
+	require.NoError(t, NewMsgInitiateTokenDeposit(addr, 1, "to", amount, []byte{1, 2, 3}).Validate(ac))
+	require.NoError(t, NewMsgInitiateTokenDeposit(addr, 1, "to", amount, []byte(`{"wasm":{}}`)).Validate(ac))
+}